@@ -0,0 +1,15 @@
+package activity
+
+import "context"
+
+// API is the set of account activity client methods, implemented by *Client
+//
+// Code that calls the account activity API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	RetrieveAuditLogs(ctx context.Context, opts ...AccountActivityOption) ([]AuditLog, *Paging, error)
+	RetrieveLoginActivity(ctx context.Context, opts ...AccountActivityOption) ([]LoginActivity, *Paging, error)
+	RetrieveSecurityHistory(ctx context.Context, opts ...AccountActivityOption) ([]SecurityHistory, *Paging, error)
+}
+
+var _ API = (*Client)(nil)
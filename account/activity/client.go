@@ -12,6 +12,7 @@ import (
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 type Client struct {
@@ -45,7 +46,7 @@ func (c *Client) RetrieveAuditLogs(ctx context.Context, opts ...AccountActivityO
 		Paging  *Paging    `json:"paging"`
 	}
 	if err := json.Unmarshal(resp.Body, &response); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal audit logs: %w", err)
+		return nil, nil, fmt.Errorf("failed to unmarshal audit logs: %w", tools.DecodeError(err, resp))
 	}
 
 	return response.Results, response.Paging, nil
@@ -71,7 +72,7 @@ func (c *Client) RetrieveLoginActivity(ctx context.Context, opts ...AccountActiv
 		Paging  *Paging         `json:"paging"`
 	}
 	if err := json.Unmarshal(resp.Body, &response); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal login activity: %w", err)
+		return nil, nil, fmt.Errorf("failed to unmarshal login activity: %w", tools.DecodeError(err, resp))
 	}
 
 	return response.Results, response.Paging, nil
@@ -97,7 +98,7 @@ func (c *Client) RetrieveSecurityHistory(ctx context.Context, opts ...AccountAct
 		Paging  *Paging           `json:"paging"`
 	}
 	if err := json.Unmarshal(resp.Body, &response); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal security history: %w", err)
+		return nil, nil, fmt.Errorf("failed to unmarshal security history: %w", tools.DecodeError(err, resp))
 	}
 
 	return response.Results, response.Paging, nil
@@ -0,0 +1,14 @@
+package info
+
+import "context"
+
+// API is the set of account info client methods, implemented by *Client
+//
+// Code that calls the account info API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	GetAccountDetails(ctx context.Context) (*AccountDetails, error)
+	RetrievePrivateAppDailyAPIUsage(ctx context.Context) ([]PrivateAppAPIUsage, error)
+}
+
+var _ API = (*Client)(nil)
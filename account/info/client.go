@@ -12,6 +12,7 @@ import (
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 type Client struct {
@@ -37,7 +38,7 @@ func (c *Client) GetAccountDetails(ctx context.Context) (*AccountDetails, error)
 
 	var details AccountDetails
 	if err := json.Unmarshal(resp.Body, &details); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal account details response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal account details response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &details, nil
@@ -58,7 +59,7 @@ func (c *Client) RetrievePrivateAppDailyAPIUsage(ctx context.Context) ([]Private
 		Results []PrivateAppAPIUsage `json:"results"`
 	}
 	if err := json.Unmarshal(resp.Body, &results); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal daily rate limits and usage for legacy private-apps: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal daily rate limits and usage for legacy private-apps: %w", tools.DecodeError(err, resp))
 	}
 
 	return results.Results, nil
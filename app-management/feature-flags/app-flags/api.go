@@ -0,0 +1,14 @@
+package appflags
+
+import "context"
+
+// API is the set of app flags client methods, implemented by *Client
+//
+// Code that calls the app flags API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	RetrieveAppFeatureFlags(ctx context.Context, appID int, flagName string) (*FlagInfo, error)
+	RetrieveAccountsWithSetFlagState(ctx context.Context, appID int, flagName string, opts ...AppFlagOption) ([]FlagState, error)
+}
+
+var _ API = (*Client)(nil)
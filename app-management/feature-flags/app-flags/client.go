@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 type Client struct {
@@ -33,7 +34,7 @@ func (c *Client) RetrieveAppFeatureFlags(ctx context.Context, appID int, flagNam
 
 	var flagInfo FlagInfo
 	if err := json.Unmarshal(resp.Body, &flagInfo); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal app feature flags: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal app feature flags: %w", tools.DecodeError(err, resp))
 	}
 
 	return &flagInfo, nil
@@ -54,7 +55,7 @@ func (c *Client) RetrieveAccountsWithSetFlagState(ctx context.Context, appID int
 		PortalFlagStates []FlagState `json:"portalFlagStates"`
 	}
 	if err := json.Unmarshal(resp.Body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal app feature flags: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal app feature flags: %w", tools.DecodeError(err, resp))
 	}
 
 	return response.PortalFlagStates, nil
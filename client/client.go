@@ -2,11 +2,17 @@
 //
 // This client will be used by other API endpoints to keep a core client to centralize configuration, logging, rate limiting, and error handling
 // All clients that implement this core client are safe for concurrency
+//
+// Every API method takes a context.Context first. For scripts and one-off tools, the crm/v3/objects/background package
+// wraps the objects, deals, companies and contacts clients with methods that use context.Background()
 package client
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,16 +22,20 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Client represents a HubSpot API client
 type Client struct {
-	config      *Config
-	httpClient  *http.Client
-	rateLimiter *RateLimiter
-	logger      *slog.Logger
+	config     *Config
+	httpClient *http.Client
+	// streamClient sends DoStream requests; it has no overall timeout, so reading a long download isn't cut off
+	streamClient *http.Client
+	rateLimiter  *RateLimiter
+	logger       *slog.Logger
 }
 
 // Handler represents a function that processes a Request and returns a Response
@@ -42,23 +52,45 @@ func NewClient(opts ...Option) (*Client, error) {
 		}
 	}
 
-	// Validate required config
+	// Environment overrides sit between the defaults and explicit options, so rebuild the config in that order
+	if prefix := cfg.envPrefix; prefix != "" {
+		cfg = NewConfig()
+		if err := applyEnv(cfg, prefix); err != nil {
+			return nil, err
+		}
+		for _, opt := range opts {
+			if err := opt(cfg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The timeout bounds the whole of a buffered request, but a streamed one only until its response headers arrive
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = cfg.Timeout
 	httpClient := &http.Client{
-		Timeout: cfg.Timeout,
+		Transport: transport,
+		Timeout:   cfg.Timeout,
 	}
 
 	// Create rate limiter
-	rateLimiter := NewRateLimiter(cfg.RateLimit.MaxBurst)
+	rateLimiter := newConfiguredRateLimiter(cfg.RateLimit)
 
 	return &Client{
-		config:      cfg,
-		httpClient:  httpClient,
-		rateLimiter: rateLimiter,
-		logger:      cfg.Logger,
+		config:       cfg,
+		httpClient:   httpClient,
+		streamClient: &http.Client{Transport: transport},
+		rateLimiter:  rateLimiter,
+		logger:       cfg.Logger,
 	}, nil
 }
 
 // Do executes the request with context using the core client and responds with the response and/or an error
+//
+// A deadline on ctx is honored alongside the client's WithTimeout setting, and whichever is shorter wins. The
+// client timeout bounds each HTTP attempt, while the ctx deadline bounds the whole call including rate limit waits
+// and retry backoff. When ctx expires the returned error wraps ctx.Err(), so errors.Is(err, context.DeadlineExceeded)
+// can be used to detect it.
 func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	req.Context = ctx
 
@@ -67,11 +99,77 @@ func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	return chain(req)
 }
 
+// DoStream executes a request like Do but returns the live HTTP response without buffering its body
+//
+// Use it for endpoints that return large, binary or non-JSON bodies, such as file and export downloads. Auth and rate
+// limiting apply as for Do, and error responses are still read and returned as a *HubSpotError. Failed attempts are
+// retried under the same policy as Do, so a POST is only retried after a server error if it is retry-safe. Responses
+// are never gzip-decoded by the client; net/http decodes them transparently instead.
+//
+// The WithTimeout setting only bounds the wait for the response headers, so a download can take as long as it needs;
+// use a ctx deadline to bound the whole download. The caller must close the returned response's body
+func (c *Client) DoStream(ctx context.Context, req *Request) (*http.Response, error) {
+	req.Context = ctx
+	req.stream = true
+
+	resp, err := c.buildChain()(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Raw, nil
+}
+
+// readOnlyPOSTSuffixes are the paths of POST endpoints that only read
+var readOnlyPOSTSuffixes = []string{"/search", "/batch/read"}
+
+// retrySafePOSTSuffixes are the paths of POST endpoints that read, or write the same result when repeated
+var retrySafePOSTSuffixes = append(slices.Clone(readOnlyPOSTSuffixes), "/batch/update", "/batch/upsert", "/batch/archive")
+
+// readOnly reports whether req only reads from HubSpot, so it is still sent in dry-run mode
+func readOnly(req *Request) bool {
+	if strings.EqualFold(req.Method, http.MethodGet) {
+		return true
+	}
+	if !strings.EqualFold(req.Method, http.MethodPost) {
+		return false
+	}
+	path := strings.TrimSuffix(req.Path, "/")
+	return slices.ContainsFunc(readOnlyPOSTSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(path, suffix)
+	})
+}
+
+// retrySafe reports whether a failed attempt of req can be sent again without repeating a side effect
+//
+// Only POSTs create records; repeating a GET, PUT, PATCH or DELETE leaves HubSpot in the same state
+func retrySafe(req *Request) bool {
+	if !strings.EqualFold(req.Method, http.MethodPost) || req.RetrySafe {
+		return true
+	}
+	path := strings.TrimSuffix(req.Path, "/")
+	for _, suffix := range retrySafePOSTSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// canRetry reports whether the retry policy allows retrying req after err
+//
+// A 429 means HubSpot rejected the request before processing it, so it is always safe to send again
+func (c *Client) canRetry(req *Request, err *HubSpotError) bool {
+	return err.Status == http.StatusTooManyRequests || c.config.Retry.NonIdempotent || retrySafe(req)
+}
+
 // buildChain constructs the complete middleware chain
 func (c *Client) buildChain() Handler {
 	// Start with the HTTP handler (innermost)
 	handler := c.httpMiddleware()
 
+	// Wrap with observe middleware so every attempt is reported
+	handler = c.wrapObserveMiddleware(handler)
+
 	// Wrap with retry middleware
 	handler = c.wrapRetryMiddleware(handler)
 
@@ -102,11 +200,111 @@ func (c *Client) PrintRateLimit(writers ...io.Writer) {
 	}
 }
 
+// RateLimitStatus returns the rate limit budget parsed from the most recent response headers
+//
+// This is safe to call concurrently with requests and is updated whether or not client-side rate limiting is enabled.
+// Only responses to requests made with the client's own token count; those made with ContextWithAccessToken report
+// another account's budget and are left out
+func (c *Client) RateLimitStatus() RateLimitSnapshot {
+	return c.rateLimiter.Snapshot()
+}
+
+// ApplyCreateDefaults returns properties merged with the create defaults configured for objectType
+//
+// Explicitly set properties win over defaults. The input map is never modified; it is returned as-is when no defaults apply
+func (c *Client) ApplyCreateDefaults(objectType string, properties map[string]string) map[string]string {
+	defaults := c.config.CreateDefaults[objectType]
+	if len(defaults) == 0 {
+		return properties
+	}
+
+	merged := make(map[string]string, len(defaults)+len(properties))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range properties {
+		merged[k] = v
+	}
+	return merged
+}
+
+// DefaultProperties returns the properties configured for objectType with WithDefaultProperties, or nil
+//
+// Requests sent through Do only get them on GETs, so service methods whose reads are POSTs, such as batch reads,
+// use it to fill in their request bodies
+func (c *Client) DefaultProperties(objectType string) []string {
+	return slices.Clone(c.config.DefaultProperties[objectType])
+}
+
+// applyDefaultProperties adds the properties configured with WithDefaultProperties to a read or list request of
+// CRM objects that doesn't request its own
+func (c *Client) applyDefaultProperties(req *Request) {
+	if len(c.config.DefaultProperties) == 0 || req.Method != http.MethodGet {
+		return
+	}
+	if _, ok := req.QueryParams["properties"]; ok {
+		return
+	}
+
+	rest, ok := strings.CutPrefix(req.Path, "/crm/v3/objects/")
+	if !ok {
+		return
+	}
+	segments := strings.Split(rest, "/")
+	if len(segments) > 2 {
+		return
+	}
+	if properties := c.config.DefaultProperties[segments[0]]; len(properties) > 0 {
+		req.AddQueryParam("properties", strings.Join(properties, ","))
+	}
+}
+
+// accessTokenKey is the context key for a per-request access token
+type accessTokenKey struct{}
+
+// ContextWithAccessToken returns a copy of ctx carrying an access token that overrides the client's own token
+//
+// This lets one shared Client, with its connection pool and rate limiter, make requests for many HubSpot accounts,
+// for example in a multi-tenant server where each incoming request has its own token. An empty token is ignored.
+// The rolling rate limit is still shared, so client-side limits apply across all accounts together, but the daily
+// quota HubSpot reports is tracked per token. Per-client state in the service packages, such as the object cache,
+// auto-batching and cached property definitions and association labels, is keyed by TenantKey so accounts never see
+// each other's data
+func ContextWithAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, accessTokenKey{}, token)
+}
+
+// AccessTokenFromContext returns the access token set on ctx with ContextWithAccessToken, if any
+func AccessTokenFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	token, ok := ctx.Value(accessTokenKey{}).(string)
+	return token, ok && token != ""
+}
+
+// TenantKey returns a key identifying the account requests made with ctx are sent for, for keying per-account state
+//
+// It is empty when ctx carries no token from ContextWithAccessToken, so the client's own token is used, and otherwise
+// a hash of that token, so the token itself is never kept in cache keys
+func TenantKey(ctx context.Context) string {
+	token, ok := AccessTokenFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
 // wrapAuthMiddleware wraps a handler with authentication
 func (c *Client) wrapAuthMiddleware(next Handler) Handler {
 	return func(req *Request) (*Response, error) {
-		if c.config.AccessToken != "" {
-			req.AddHeader("Authorization", fmt.Sprintf("Bearer %s", c.config.AccessToken))
+		token := c.config.AccessToken
+		if ctxToken, ok := AccessTokenFromContext(req.Context); ok {
+			token = ctxToken
+		}
+		if token != "" {
+			req.AddHeader("Authorization", fmt.Sprintf("Bearer %s", token))
 		}
 		return next(req)
 	}
@@ -115,11 +313,16 @@ func (c *Client) wrapAuthMiddleware(next Handler) Handler {
 // wrapRateLimitMiddleware wraps a handler with rate limiting
 func (c *Client) wrapRateLimitMiddleware(next Handler) Handler {
 	return func(req *Request) (*Response, error) {
+		tenant := TenantKey(req.Context)
 		if !c.config.RateLimit.Enabled {
-			return next(req)
+			resp, err := next(req)
+			if resp != nil {
+				c.rateLimiter.updateFromResponseFor(tenant, resp)
+			}
+			return resp, err
 		}
 
-		if !c.rateLimiter.CheckDailyLimit() {
+		if !c.rateLimiter.checkDailyLimitFor(tenant) {
 			return nil, &HubSpotError{
 				Status:      429,
 				Message:     "Daily API limit exceeded",
@@ -129,14 +332,18 @@ func (c *Client) wrapRateLimitMiddleware(next Handler) Handler {
 			}
 		}
 
-		if err := c.rateLimiter.Wait(req.Context); err != nil {
+		waited, err := c.rateLimiter.waitFor(req.Context, req)
+		if err != nil {
 			return nil, err
 		}
+		if waitObserver, ok := c.config.Observer.(RateLimitWaitObserver); ok && waited > 0 {
+			waitObserver.ObserveRateLimitWait(req.ResourceType, waited)
+		}
 
 		resp, err := next(req)
 
 		if resp != nil {
-			c.rateLimiter.UpdateFromResponse(resp)
+			c.rateLimiter.updateFromResponseFor(tenant, resp)
 		}
 
 		return resp, err
@@ -152,9 +359,13 @@ func (c *Client) wrapRetryMiddleware(next Handler) Handler {
 
 		var lastErr error
 		var lastResp *Response
+		start := time.Now()
 
 		for attempt := 0; attempt < c.config.Retry.MaxAttempts; attempt++ {
 			req.RetryCount = attempt
+			if attempt > 0 && c.config.Observer != nil {
+				c.config.Observer.ObserveRetry(req.ResourceType, attempt)
+			}
 
 			resp, err := next(req)
 
@@ -166,12 +377,15 @@ func (c *Client) wrapRetryMiddleware(next Handler) Handler {
 			lastErr = err
 
 			if hubspotErr, ok := err.(*HubSpotError); ok {
-				if !hubspotErr.IsRetryable {
+				if !hubspotErr.IsRetryable || !c.canRetry(req, hubspotErr) {
 					return resp, err
 				}
 
 				if attempt < c.config.Retry.MaxAttempts-1 {
 					backoff := calculateBackoffDuration(attempt, hubspotErr.RetryAfter, c.config.Retry)
+					if maxElapsed := c.config.Retry.MaxElapsed; maxElapsed > 0 && time.Since(start)+backoff > maxElapsed {
+						return lastResp, lastErr
+					}
 					select {
 					case <-time.After(backoff):
 					case <-req.Context.Done():
@@ -187,7 +401,7 @@ func (c *Client) wrapRetryMiddleware(next Handler) Handler {
 	}
 }
 
-// calculateBackoffDuration calculates exponential backoff with jitter
+// calculateBackoffDuration calculates exponential backoff, with full jitter when enabled
 func calculateBackoffDuration(attempt int, retryAfter time.Duration, cfg RetryConfig) time.Duration {
 	// If Retry-After header was provided, respect it
 	if retryAfter > 0 {
@@ -197,45 +411,61 @@ func calculateBackoffDuration(attempt int, retryAfter time.Duration, cfg RetryCo
 	// Calculate exponential backoff: initial * 2^attempt
 	backoff := time.Duration(math.Pow(2, float64(attempt))) * cfg.InitialBackoff
 
-	// Add jitter: ±10% randomness
-	jitter := time.Duration(rand.Int63n(int64(backoff / 10)))
-	if rand.Intn(2) == 0 {
-		backoff += jitter
-	} else {
-		backoff -= jitter
-	}
-
 	// Cap at max backoff
-	if backoff > cfg.MaxBackoff {
+	if backoff > cfg.MaxBackoff || backoff <= 0 {
 		backoff = cfg.MaxBackoff
 	}
 
+	// Full jitter: pick uniformly between 0 and the computed delay
+	if cfg.Jitter && backoff > 0 {
+		backoff = time.Duration(rand.Int63n(int64(backoff) + 1))
+	}
+
 	return backoff
 }
 
 // httpMiddleware performs the actual HTTP request
 func (c *Client) httpMiddleware() Handler {
 	return func(req *Request) (*Response, error) {
+		pageSize := c.config.DefaultPageSize
+		if req.Paged && pageSize > 0 && req.Body == nil {
+			if _, ok := req.QueryParams["limit"]; !ok {
+				req.AddQueryParam("limit", strconv.Itoa(pageSize))
+			}
+		}
+
+		c.applyDefaultProperties(req)
+
 		// Build full URL
 		fullURL := c.config.BaseURL + req.Path
 
 		// Add query parameters
-		if len(req.QueryParams) > 0 {
+		if len(req.QueryParams) > 0 || len(req.RepeatedQueryParams) > 0 {
 			values := url.Values{}
 			for k, v := range req.QueryParams {
 				values.Add(k, v)
 			}
+			for k, vs := range req.RepeatedQueryParams {
+				for _, v := range vs {
+					values.Add(k, v)
+				}
+			}
 			fullURL += "?" + values.Encode()
 		}
 
 		// Prepare request body
 		var bodyReader io.Reader
+		var bodyBytes []byte
 		if req.Body != nil {
-			bodyBytes, err := marshalRequestBody(req.Body)
+			var err error
+			bodyBytes, err = marshalRequestBody(req.Body)
 			if err != nil {
 				c.logger.Error("Failed to marshal request body", "Error", err)
 				return nil, fmt.Errorf("failed to marshal request body: %w", err)
 			}
+			if req.Paged && pageSize > 0 {
+				bodyBytes = withDefaultLimit(bodyBytes, pageSize)
+			}
 			bodyReader = bytes.NewReader(bodyBytes)
 			req.AddHeader("Content-Type", "application/json")
 		}
@@ -247,22 +477,52 @@ func (c *Client) httpMiddleware() Handler {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
 
+		// Set default headers, which the request's own headers override
+		httpReq.Header.Set("User-Agent", c.config.UserAgent)
+
 		// Copy headers from request wrapper
 		for k, v := range req.Headers {
 			httpReq.Header.Set(k, v)
 		}
 
-		// Set default headers
-		httpReq.Header.Set("User-Agent", "go-hubspot-sdk/1.0")
+		if c.config.Compression && !req.stream {
+			httpReq.Header.Set("Accept-Encoding", "gzip")
+		}
+
+		// Successful requests are sampled, error responses are always logged
+		sampled := c.sampleLog(req)
+		if sampled {
+			c.logger.Debug("Making API Request!", slog.Group("Request Data", "Request Method", req.Method, "Request URL", fullURL, "Request Headers", httpReq.Header))
+		}
+
+		if c.config.RequestCapture != nil {
+			c.config.RequestCapture(req.Method, fullURL, bytes.Clone(bodyBytes))
+		}
 
-		c.logger.Debug("Making API Request!", slog.Group("Request Data", "Request Method", req.Method, "Request URL", fullURL, "Request Headers", httpReq.Header))
+		if c.config.DryRun && !readOnly(req) {
+			c.logger.Info("Dry run, request not sent", "Request Method", req.Method, "Request URL", fullURL)
+			return nil, fmt.Errorf("%s %s: %w", req.Method, req.Path, ErrDryRun)
+		}
 
 		// Perform request
-		httpResp, err := c.httpClient.Do(httpReq)
+		httpClient := c.httpClient
+		if req.stream {
+			httpClient = c.streamClient
+		}
+		httpResp, err := httpClient.Do(httpReq)
 		if err != nil {
 			c.logger.Error("HTTP request failed", "Error", err)
 			return nil, fmt.Errorf("HTTP request failed: %w", err)
 		}
+
+		// A successful streamed response is handed to the caller unread
+		if req.stream && httpResp.StatusCode < 400 {
+			resp := NewResponse(httpResp.StatusCode, nil, httpResp.Header)
+			resp.RateLimit = ExtractRateLimitInfo(httpResp.Header)
+			resp.Raw = httpResp
+			return resp, nil
+		}
+
 		defer func() {
 			err = httpResp.Body.Close()
 			if err != nil {
@@ -277,11 +537,22 @@ func (c *Client) httpMiddleware() Handler {
 			return nil, fmt.Errorf("failed to read response body: %w", err)
 		}
 
+		// Setting Accept-Encoding ourselves disables net/http's transparent decompression
+		if c.config.Compression {
+			respBodyBytes, err = decompressBody(respBodyBytes, httpResp.Header)
+			if err != nil {
+				c.logger.Error("Failed to decompress response body", "Error", err)
+				return nil, fmt.Errorf("failed to decompress response body: %w", err)
+			}
+		}
+
 		// Create response wrapper
 		resp := NewResponse(httpResp.StatusCode, respBodyBytes, httpResp.Header)
 		resp.RateLimit = ExtractRateLimitInfo(httpResp.Header)
 
-		c.logger.Debug("Response Received!", "Response", *resp)
+		if sampled || httpResp.StatusCode >= 400 {
+			c.logger.Debug("Response Received!", "Response", *resp)
+		}
 
 		// Handle error responses
 		if httpResp.StatusCode >= 400 {
@@ -294,6 +565,16 @@ func (c *Client) httpMiddleware() Handler {
 	}
 }
 
+// sampleLog reports whether the debug logs for a request attempt should be written
+//
+// Retried attempts are always logged; first attempts are sampled at the configured LogSampleRate
+func (c *Client) sampleLog(req *Request) bool {
+	if req.RetryCount > 0 || c.config.LogSampleRate >= 1 {
+		return true
+	}
+	return rand.Float64() < c.config.LogSampleRate
+}
+
 // marshalRequestBody marshals the request body to JSON bytes
 func marshalRequestBody(body any) ([]byte, error) {
 	switch v := body.(type) {
@@ -306,6 +587,26 @@ func marshalRequestBody(body any) ([]byte, error) {
 	}
 }
 
+// withDefaultLimit returns body with its limit field set to limit when the field is missing or zero
+//
+// Bodies that aren't JSON objects are returned unchanged
+func withDefaultLimit(body []byte, limit int) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return body
+	}
+	if current, ok := fields["limit"]; ok && string(current) != "0" && string(current) != "null" {
+		return body
+	}
+
+	fields["limit"] = json.RawMessage(strconv.Itoa(limit))
+	patched, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return patched
+}
+
 // readResponseBody reads the HTTP response body
 func readResponseBody(httpResp *http.Response) ([]byte, error) {
 	defer httpResp.Body.Close()
@@ -333,6 +634,27 @@ func readResponseBody(httpResp *http.Response) ([]byte, error) {
 	return bodyBytes, nil
 }
 
+// decompressBody gunzips a response body marked as gzip-encoded
+//
+// Bodies that don't start with the gzip magic number are returned unchanged, since some responses
+// (e.g. empty or proxy-generated ones) carry the header without actually being compressed
+func decompressBody(body []byte, headers http.Header) ([]byte, error) {
+	if !strings.EqualFold(headers.Get("Content-Encoding"), "gzip") {
+		return body, nil
+	}
+	if len(body) < 2 || body[0] != 0x1f || body[1] != 0x8b {
+		return body, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
 // jsonMarshal is a wrapper around json.Marshal for consistency
 func jsonMarshal(v any) ([]byte, error) {
 	return json.Marshal(v)
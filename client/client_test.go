@@ -1,10 +1,20 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -251,6 +261,49 @@ func TestClientDo_ContextCancellation(t *testing.T) {
 	assert.Contains(t, err.Error(), "context canceled")
 }
 
+// TestClientDo_ContextDeadline tests that the shorter of the context deadline and client timeout wins
+func TestClientDo_ContextDeadline(t *testing.T) {
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+		respondJSON(w, http.StatusOK, `{"success": true}`)
+	}
+
+	t.Run("Context deadline shorter than client timeout", func(t *testing.T) {
+		server, client := setupMockServer(t, slow)
+		defer server.Close()
+		client.httpClient.Timeout = 5 * time.Second
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.Do(ctx, NewRequest("GET", "/test"))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Client timeout shorter than context deadline", func(t *testing.T) {
+		server, client := setupMockServer(t, slow)
+		defer server.Close()
+		client.httpClient.Timeout = 50 * time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.Do(ctx, NewRequest("GET", "/test"))
+
+		require.Error(t, err)
+		assert.NoError(t, ctx.Err())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
 // TestAuthMiddleware tests authentication header injection
 func TestAuthMiddleware(t *testing.T) {
 	t.Run("With access token", func(t *testing.T) {
@@ -284,6 +337,30 @@ func TestAuthMiddleware(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resp)
 	})
+
+	t.Run("With access token from context", func(t *testing.T) {
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer tenant-token", r.Header.Get("Authorization"))
+			respondJSON(w, http.StatusOK, `{"success": true}`)
+		})
+		defer server.Close()
+
+		ctx := ContextWithAccessToken(context.Background(), "tenant-token")
+		_, err := client.Do(ctx, NewRequest("GET", "/test"))
+		require.NoError(t, err)
+	})
+
+	t.Run("With empty access token in context", func(t *testing.T) {
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			respondJSON(w, http.StatusOK, `{"success": true}`)
+		})
+		defer server.Close()
+
+		ctx := ContextWithAccessToken(context.Background(), "")
+		_, err := client.Do(ctx, NewRequest("GET", "/test"))
+		require.NoError(t, err)
+	})
 }
 
 // TestRetryMiddleware tests retry logic
@@ -361,6 +438,41 @@ func TestRetryMiddleware(t *testing.T) {
 		require.Error(t, err)
 		assert.Equal(t, 1, attempts) // No retry
 	})
+
+	t.Run("Max elapsed stops retrying", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			respondJSON(w, 500, `{"status": "error", "message": "Server error"}`)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(
+			WithBaseURL(server.URL),
+			WithRateLimitEnabled(false),
+			WithRetryMaxAttempts(10),
+			WithRetryBackoff(40*time.Millisecond, time.Second),
+			WithRetryJitter(false),
+			WithRetryMaxElapsed(100*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		start := time.Now()
+		req := NewRequest("GET", "/test")
+		_, err = client.Do(context.Background(), req)
+
+		require.Error(t, err)
+		var hubspotErr *HubSpotError
+		require.ErrorAs(t, err, &hubspotErr)
+		assert.Equal(t, 500, hubspotErr.Status)
+		assert.Equal(t, 2, attempts) // 40ms then 80ms backoff would pass 100ms
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("Negative max elapsed", func(t *testing.T) {
+		_, err := NewClient(WithRetryMaxElapsed(-time.Second))
+		require.Error(t, err)
+	})
 }
 
 // TestRateLimitMiddleware tests rate limiting
@@ -411,6 +523,49 @@ func TestRateLimitMiddleware(t *testing.T) {
 		assert.Equal(t, 429, hubspotErr.Status)
 		assert.Contains(t, hubspotErr.Message, "Daily API limit exceeded")
 	})
+
+	t.Run("Daily limit tracked per context token", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remaining := "100"
+			if r.Header.Get("Authorization") == "Bearer tenant-a" {
+				remaining = "0"
+			}
+			w.Header().Set("X-HubSpot-RateLimit-Daily", "250000")
+			w.Header().Set("X-HubSpot-RateLimit-Daily-Remaining", remaining)
+			respondJSON(w, http.StatusOK, `{}`)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(true), WithRetryEnabled(false))
+		require.NoError(t, err)
+
+		tenantA := ContextWithAccessToken(context.Background(), "tenant-a")
+		tenantB := ContextWithAccessToken(context.Background(), "tenant-b")
+
+		_, err = client.Do(tenantA, NewRequest("GET", "/test"))
+		require.NoError(t, err)
+
+		_, err = client.Do(tenantA, NewRequest("GET", "/test"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Daily API limit exceeded")
+
+		_, err = client.Do(tenantB, NewRequest("GET", "/test"))
+		assert.NoError(t, err)
+		_, err = client.Do(context.Background(), NewRequest("GET", "/test"))
+		assert.NoError(t, err)
+	})
+}
+
+// TestTenantKey tests that tenant keys separate context tokens without exposing them
+func TestTenantKey(t *testing.T) {
+	assert.Empty(t, TenantKey(context.Background()))
+
+	a := TenantKey(ContextWithAccessToken(context.Background(), "token-a"))
+	b := TenantKey(ContextWithAccessToken(context.Background(), "token-b"))
+	assert.NotEmpty(t, a)
+	assert.NotEqual(t, a, b)
+	assert.NotContains(t, a, "token-a")
+	assert.Equal(t, a, TenantKey(ContextWithAccessToken(context.Background(), "token-a")))
 }
 
 // TestMarshalRequestBody tests body marshaling
@@ -461,6 +616,33 @@ func TestCalculateBackoffDuration(t *testing.T) {
 		backoff := calculateBackoffDuration(10, 0, cfg)
 		assert.LessOrEqual(t, backoff, cfg.MaxBackoff)
 	})
+
+	t.Run("Full jitter", func(t *testing.T) {
+		jittered := cfg
+		jittered.Jitter = true
+
+		seen := map[time.Duration]bool{}
+		for range 100 {
+			backoff := calculateBackoffDuration(2, 0, jittered)
+			assert.GreaterOrEqual(t, backoff, time.Duration(0))
+			assert.LessOrEqual(t, backoff, 4*time.Second)
+			seen[backoff] = true
+		}
+		assert.Greater(t, len(seen), 1, "backoffs are randomized")
+	})
+
+	t.Run("Jitter disabled", func(t *testing.T) {
+		assert.Equal(t, 4*time.Second, calculateBackoffDuration(2, 0, cfg))
+		assert.Equal(t, 30*time.Second, calculateBackoffDuration(10, 0, cfg))
+	})
+
+	t.Run("Jitter on by default", func(t *testing.T) {
+		assert.True(t, NewConfig().Retry.Jitter)
+
+		client, err := NewClient(WithRetryJitter(false))
+		require.NoError(t, err)
+		assert.False(t, client.config.Retry.Jitter)
+	})
 }
 
 // TestRequest tests Request helper methods
@@ -497,10 +679,49 @@ func TestRequest(t *testing.T) {
 		assert.Equal(t, "10", req.QueryParams["limit"])
 	})
 
+	t.Run("AppendQueryParam", func(t *testing.T) {
+		req := NewRequest("GET", "/test").AppendQueryParam("properties", "a,b")
+		req.AppendQueryParam("properties", "b,c").AppendQueryParam("properties", "")
+		assert.Equal(t, "a,b,c", req.QueryParams["properties"])
+
+		req.AppendQueryParam("associations", "")
+		_, ok := req.QueryParams["associations"]
+		assert.False(t, ok)
+	})
+
+	t.Run("AddRepeatedQueryParam", func(t *testing.T) {
+		var query url.Values
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			respondJSON(w, 200, `{}`)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false))
+		require.NoError(t, err)
+
+		req := NewRequest("GET", "/test").AddRepeatedQueryParam("listIds", "1", "2").AddRepeatedQueryParam("listIds", "3")
+		req.AddQueryParam("includeFilters", "true")
+		_, err = client.Do(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, query["listIds"])
+		assert.Equal(t, "true", query.Get("includeFilters"))
+	})
+
 	t.Run("AddHeader", func(t *testing.T) {
 		req := NewRequest("GET", "/test").AddHeader("X-Custom", "value")
 		assert.Equal(t, "value", req.Headers["X-Custom"])
 	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		req := NewRequest("GET", "/test").SetMetadata("ordered", true)
+		value, ok := req.GetMetadata("ordered")
+		assert.True(t, ok)
+		assert.Equal(t, true, value)
+
+		_, ok = req.GetMetadata("missing")
+		assert.False(t, ok)
+	})
 }
 
 // TestResponse tests Response methods
@@ -562,6 +783,64 @@ func TestHubSpotError(t *testing.T) {
 		}
 		assert.Contains(t, err.Error(), "status 500")
 	})
+
+	t.Run("Category predicates", func(t *testing.T) {
+		validation := &HubSpotError{Status: 400, Category: CategoryValidation}
+		assert.True(t, validation.IsValidation())
+		assert.False(t, validation.IsNotFound())
+
+		assert.True(t, (&HubSpotError{Status: 429}).IsRateLimited())
+		assert.True(t, (&HubSpotError{Status: 400, Category: CategoryRateLimits}).IsRateLimited())
+		assert.True(t, (&HubSpotError{Status: 404}).IsNotFound())
+		assert.True(t, (&HubSpotError{Status: 400, Category: CategoryObjectNotFound}).IsNotFound())
+		assert.True(t, (&HubSpotError{Status: 409}).IsConflict())
+		assert.False(t, (&HubSpotError{Status: 500}).IsConflict())
+
+		var nilErr *HubSpotError
+		assert.False(t, nilErr.IsValidation())
+	})
+
+	t.Run("AsHubSpotError", func(t *testing.T) {
+		original := &HubSpotError{Status: 404}
+
+		found, ok := AsHubSpotError(fmt.Errorf("reading contact: %w", original))
+		require.True(t, ok)
+		assert.Same(t, original, found)
+
+		_, ok = AsHubSpotError(errors.New("plain"))
+		assert.False(t, ok)
+	})
+}
+
+// TestParseNotFoundError tests converting not-found HubSpot errors into a NotFoundError
+func TestParseNotFoundError(t *testing.T) {
+	t.Run("Not found", func(t *testing.T) {
+		original := &HubSpotError{Status: 404, Message: "Not found"}
+
+		err := ParseNotFoundError(original, "deals", "123")
+		var notFound *NotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Equal(t, "deals", notFound.ObjectType)
+		assert.Equal(t, "123", notFound.ObjectID)
+		assert.Equal(t, "deals 123 not found", err.Error())
+
+		found, ok := AsHubSpotError(err)
+		require.True(t, ok)
+		assert.Same(t, original, found)
+	})
+
+	t.Run("Other errors unchanged", func(t *testing.T) {
+		original := &HubSpotError{Status: 500}
+		assert.Same(t, original, ParseNotFoundError(original, "deals", "123"))
+
+		plain := errors.New("plain")
+		assert.Equal(t, plain, ParseNotFoundError(plain, "deals", "123"))
+	})
+
+	t.Run("Without ID", func(t *testing.T) {
+		assert.Equal(t, "contacts not found", (&NotFoundError{ObjectType: "contacts"}).Error())
+		assert.Nil(t, (&NotFoundError{ObjectType: "contacts"}).Unwrap())
+	})
 }
 
 // TestParseHubSpotError tests error parsing
@@ -587,6 +866,35 @@ func TestParseHubSpotError(t *testing.T) {
 		assert.False(t, err.IsRetryable) // 400 is not retryable
 	})
 
+	t.Run("Parse error details", func(t *testing.T) {
+		body := []byte(`{
+			"status": "error",
+			"message": "Property values were not valid",
+			"category": "VALIDATION_ERROR",
+			"errors": [
+				{"message": "Email address is invalid", "in": "email", "code": "INVALID_EMAIL", "subCategory": "INVALID_EMAIL", "context": {"propertyName": ["email"]}},
+				{"message": "Property does not exist", "in": "favorite_color", "code": "PROPERTY_DOESNT_EXIST"}
+			]
+		}`)
+
+		err := ParseHubSpotError(400, body, http.Header{})
+		assert.Equal(t, "Property values were not valid", err.Message)
+		require.Len(t, err.Errors, 2)
+		assert.Equal(t, "email", err.Errors[0].In)
+		assert.Equal(t, "INVALID_EMAIL", err.Errors[0].Code)
+		assert.Equal(t, "INVALID_EMAIL", err.Errors[0].SubCategory)
+		assert.Equal(t, []string{"email"}, err.Errors[0].Context["propertyName"])
+		assert.Contains(t, err.Error(), "email: Email address is invalid; favorite_color: Property does not exist")
+	})
+
+	t.Run("Parse unexpected error details", func(t *testing.T) {
+		body := []byte(`{"message": "Invalid input", "errors": "not a list"}`)
+
+		err := ParseHubSpotError(400, body, http.Header{})
+		assert.Equal(t, "Invalid input", err.Message)
+		assert.Empty(t, err.Errors)
+	})
+
 	t.Run("Parse with Retry-After header (seconds)", func(t *testing.T) {
 		body := []byte(`{"status": "error", "message": "Rate limited"}`)
 		headers := http.Header{}
@@ -712,4 +1020,869 @@ func TestRateLimiter(t *testing.T) {
 		assert.Equal(t, 250000, rl.dailyLimit)
 		assert.Equal(t, 249000, rl.dailyRemaining)
 	})
+
+	t.Run("UpdateFromResponse without daily headers", func(t *testing.T) {
+		rl := NewRateLimiter(100)
+		rl.UpdateFromResponse(&Response{RateLimit: RateLimitInfo{Max: 100, Remaining: 50}})
+		assert.True(t, rl.CheckDailyLimit())
+		assert.Equal(t, 250000, rl.GetDailyRemaining())
+	})
+}
+
+// TestRateLimitStatus tests the rate limit snapshot exposed on the client
+func TestRateLimitStatus(t *testing.T) {
+	t.Run("Zero before any response", func(t *testing.T) {
+		client, err := NewClient()
+		require.NoError(t, err)
+
+		status := client.RateLimitStatus()
+		assert.True(t, status.UpdatedAt.IsZero())
+		assert.Equal(t, 0, status.Remaining)
+	})
+
+	t.Run("Reflects latest response headers", func(t *testing.T) {
+		remaining := 99
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-HubSpot-RateLimit-Max", "100")
+			w.Header().Set("X-HubSpot-RateLimit-Remaining", strconv.Itoa(remaining))
+			w.Header().Set("X-HubSpot-RateLimit-Interval-Milliseconds", "10000")
+			w.Header().Set("X-HubSpot-RateLimit-Daily", "250000")
+			w.Header().Set("X-HubSpot-RateLimit-Daily-Remaining", strconv.Itoa(remaining+249000))
+			remaining--
+			respondJSON(w, http.StatusOK, `{"success": true}`)
+		})
+		defer server.Close()
+
+		_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+		require.NoError(t, err)
+
+		status := client.RateLimitStatus()
+		assert.Equal(t, 100, status.Max)
+		assert.Equal(t, 99, status.Remaining)
+		assert.Equal(t, 10000, status.IntervalMs)
+		assert.Equal(t, 250000, status.DailyLimit)
+		assert.Equal(t, 249099, status.DailyRemaining)
+		assert.False(t, status.UpdatedAt.IsZero())
+
+		_, err = client.Do(context.Background(), NewRequest("GET", "/test"))
+		require.NoError(t, err)
+
+		status = client.RateLimitStatus()
+		assert.Equal(t, 98, status.Remaining)
+		assert.Equal(t, 249098, status.DailyRemaining)
+
+		ctx := ContextWithAccessToken(context.Background(), "other-account-token")
+		_, err = client.Do(ctx, NewRequest("GET", "/test").WithContext(ctx))
+		require.NoError(t, err)
+
+		status = client.RateLimitStatus()
+		assert.Equal(t, 98, status.Remaining, "another account's response is left out")
+		assert.Equal(t, 249098, status.DailyRemaining)
+	})
+}
+
+// TestCreateDefaults tests merging configured create defaults into properties
+func TestCreateDefaults(t *testing.T) {
+	t.Run("Defaults only fill unset properties", func(t *testing.T) {
+		client, err := NewClient(WithCreateDefaults("contacts", map[string]string{
+			"lifecyclestage": "lead",
+			"hs_lead_status": "NEW",
+		}))
+		require.NoError(t, err)
+
+		props := map[string]string{"email": "a@example.com", "lifecyclestage": "customer"}
+		merged := client.ApplyCreateDefaults("contacts", props)
+
+		assert.Equal(t, map[string]string{
+			"email":          "a@example.com",
+			"lifecyclestage": "customer",
+			"hs_lead_status": "NEW",
+		}, merged)
+		assert.Len(t, props, 2, "input map must not be modified")
+	})
+
+	t.Run("Other object types are untouched", func(t *testing.T) {
+		client, err := NewClient(WithCreateDefaults("contacts", map[string]string{"lifecyclestage": "lead"}))
+		require.NoError(t, err)
+
+		props := map[string]string{"name": "Acme"}
+		assert.Equal(t, props, client.ApplyCreateDefaults("companies", props))
+	})
+
+	t.Run("Repeated options merge", func(t *testing.T) {
+		client, err := NewClient(
+			WithCreateDefaults("deals", map[string]string{"pipeline": "default", "dealstage": "a"}),
+			WithCreateDefaults("deals", map[string]string{"dealstage": "b"}),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{"pipeline": "default", "dealstage": "b"}, client.ApplyCreateDefaults("deals", nil))
+	})
+}
+
+// TestCompression tests gzip response handling
+func TestCompression(t *testing.T) {
+	gzipBody := func(t *testing.T, body string) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, err := gz.Write([]byte(body))
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+		return buf.Bytes()
+	}
+
+	t.Run("Decompresses gzip responses", func(t *testing.T) {
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(gzipBody(t, `{"results": [1, 2, 3]}`))
+		})
+		defer server.Close()
+		client.config.Compression = true
+
+		resp, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"results": [1, 2, 3]}`, string(resp.Body))
+	})
+
+	t.Run("Passes through bodies that are not actually gzipped", func(t *testing.T) {
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			respondJSON(w, http.StatusOK, `{"plain": true}`)
+		})
+		defer server.Close()
+		client.config.Compression = true
+
+		resp, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"plain": true}`, string(resp.Body))
+	})
+
+	t.Run("Decompresses error responses", func(t *testing.T) {
+		server, client := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(gzipBody(t, `{"status": "error", "message": "bad input", "category": "VALIDATION_ERROR"}`))
+		})
+		defer server.Close()
+		client.config.Compression = true
+
+		_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+
+		require.Error(t, err)
+		hubspotErr, ok := err.(*HubSpotError)
+		require.True(t, ok)
+		assert.Equal(t, "bad input", hubspotErr.Message)
+	})
+
+	t.Run("WithCompression option", func(t *testing.T) {
+		client, err := NewClient(WithCompression(true))
+		require.NoError(t, err)
+		assert.True(t, client.config.Compression)
+	})
+}
+
+// TestWithDeadlineFromEnv tests environment overrides for timeout and retry settings
+func TestWithDeadlineFromEnv(t *testing.T) {
+	t.Run("Applies environment values", func(t *testing.T) {
+		t.Setenv("HUBSPOT_TIMEOUT", "45s")
+		t.Setenv("HUBSPOT_MAX_RETRIES", "7")
+		t.Setenv("HUBSPOT_RETRY_ENABLED", "false")
+		t.Setenv("HUBSPOT_RETRY_INITIAL_BACKOFF", "250ms")
+		t.Setenv("HUBSPOT_RETRY_MAX_BACKOFF", "1m")
+		t.Setenv("HUBSPOT_RETRY_MAX_ELAPSED", "2m")
+
+		client, err := NewClient(WithDeadlineFromEnv(""))
+		require.NoError(t, err)
+		assert.Equal(t, 45*time.Second, client.config.Timeout)
+		assert.Equal(t, 45*time.Second, client.httpClient.Timeout)
+		assert.Equal(t, 8, client.config.Retry.MaxAttempts, "7 retries after the first attempt")
+		assert.False(t, client.config.Retry.Enabled)
+		assert.Equal(t, 250*time.Millisecond, client.config.Retry.InitialBackoff)
+		assert.Equal(t, time.Minute, client.config.Retry.MaxBackoff)
+		assert.Equal(t, 2*time.Minute, client.config.Retry.MaxElapsed)
+	})
+
+	t.Run("Custom prefix", func(t *testing.T) {
+		t.Setenv("MYAPP_TIMEOUT", "5s")
+
+		client, err := NewClient(WithDeadlineFromEnv("MYAPP"))
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, client.config.Timeout)
+		assert.Equal(t, 3, client.config.Retry.MaxAttempts)
+	})
+
+	t.Run("Explicit options win regardless of order", func(t *testing.T) {
+		t.Setenv("HUBSPOT_TIMEOUT", "45s")
+		t.Setenv("HUBSPOT_MAX_RETRIES", "7")
+
+		client, err := NewClient(WithTimeout(10*time.Second), WithDeadlineFromEnv("HUBSPOT"))
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Second, client.config.Timeout)
+		assert.Equal(t, 8, client.config.Retry.MaxAttempts)
+	})
+
+	t.Run("Malformed values error", func(t *testing.T) {
+		t.Setenv("HUBSPOT_TIMEOUT", "thirty")
+
+		_, err := NewClient(WithDeadlineFromEnv("HUBSPOT"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "HUBSPOT_TIMEOUT")
+	})
+
+	t.Run("Zero retries", func(t *testing.T) {
+		t.Setenv("HUBSPOT_MAX_RETRIES", "0")
+
+		client, err := NewClient(WithDeadlineFromEnv("HUBSPOT"))
+		require.NoError(t, err)
+		assert.Equal(t, 1, client.config.Retry.MaxAttempts)
+	})
+
+	t.Run("Negative retries error", func(t *testing.T) {
+		t.Setenv("HUBSPOT_MAX_RETRIES", "-1")
+
+		_, err := NewClient(WithDeadlineFromEnv("HUBSPOT"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "HUBSPOT_MAX_RETRIES")
+	})
+}
+
+// TestLogSampling tests that successful requests are sampled while errors are always logged
+func TestLogSampling(t *testing.T) {
+	newLoggedClient := func(t *testing.T, handler http.HandlerFunc, opts ...Option) (*Client, *bytes.Buffer) {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+
+		var logs bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		client, err := NewClient(append([]Option{
+			WithBaseURL(server.URL),
+			WithRateLimitEnabled(false),
+			WithRetryEnabled(false),
+			WithLogger(logger),
+		}, opts...)...)
+		require.NoError(t, err)
+		return client, &logs
+	}
+
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{}`)
+	}
+
+	t.Run("Samples successes at the configured rate", func(t *testing.T) {
+		client, logs := newLoggedClient(t, ok, WithLogSampling(0.25))
+
+		const requests = 400
+		for range requests {
+			_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+			require.NoError(t, err)
+		}
+
+		logged := strings.Count(logs.String(), "Response Received!")
+		assert.InDelta(t, requests/4, logged, 50)
+	})
+
+	t.Run("Zero rate logs no successes", func(t *testing.T) {
+		client, logs := newLoggedClient(t, ok, WithLogSampling(0))
+
+		for range 20 {
+			_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+			require.NoError(t, err)
+		}
+
+		assert.Empty(t, logs.String())
+	})
+
+	t.Run("Errors are always logged", func(t *testing.T) {
+		client, logs := newLoggedClient(t, func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "bad request", "category": "VALIDATION_ERROR"}`)
+		}, WithLogSampling(0))
+
+		for range 20 {
+			_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+			require.Error(t, err)
+		}
+
+		assert.Equal(t, 20, strings.Count(logs.String(), "Error Response Received!"))
+	})
+
+	t.Run("Retried attempts are always logged", func(t *testing.T) {
+		attempts := 0
+		client, logs := newLoggedClient(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts == 1 {
+				respondJSON(w, http.StatusInternalServerError, `{"status": "error", "message": "boom"}`)
+				return
+			}
+			respondJSON(w, http.StatusOK, `{}`)
+		}, WithLogSampling(0), WithRetryEnabled(true), WithRetryBackoff(time.Millisecond, time.Millisecond))
+
+		_, err := client.Do(context.Background(), NewRequest("GET", "/test"))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, strings.Count(logs.String(), "Making API Request!"))
+		assert.Contains(t, logs.String(), "Error Response Received!")
+	})
+
+	t.Run("Invalid rate", func(t *testing.T) {
+		_, err := NewClient(WithLogSampling(1.5))
+		assert.Error(t, err)
+	})
+}
+
+// TestWithRegion tests region selection and how it composes with WithBaseURL
+func TestWithRegion(t *testing.T) {
+	t.Run("Default region", func(t *testing.T) {
+		client, err := NewClient()
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.hubapi.com", client.config.BaseURL)
+	})
+
+	t.Run("EU region", func(t *testing.T) {
+		client, err := NewClient(WithRegion(RegionEU1))
+		require.NoError(t, err)
+		assert.Equal(t, "https://api-eu1.hubapi.com", client.config.BaseURL)
+	})
+
+	t.Run("Last option wins", func(t *testing.T) {
+		client, err := NewClient(WithRegion(RegionEU1), WithBaseURL("http://localhost:8080"))
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:8080", client.config.BaseURL)
+
+		client, err = NewClient(WithBaseURL("http://localhost:8080"), WithRegion(RegionNA1))
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.hubapi.com", client.config.BaseURL)
+	})
+
+	t.Run("Unknown region", func(t *testing.T) {
+		_, err := NewClient(WithRegion("mars1"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mars1")
+	})
+}
+
+// recordingObserver records every observation for assertions
+type recordingObserver struct {
+	mu         sync.Mutex
+	statuses   []int
+	labels     []string
+	retries    []int
+	remainings []int
+	waits      map[string][]time.Duration
+}
+
+func (o *recordingObserver) ObserveRequest(resourceType, method string, status int, dur time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.statuses = append(o.statuses, status)
+	o.labels = append(o.labels, resourceType+" "+method)
+}
+
+func (o *recordingObserver) ObserveRetry(resourceType string, attempt int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.retries = append(o.retries, attempt)
+}
+
+func (o *recordingObserver) ObserveRateLimit(resourceType string, info RateLimitInfo) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.remainings = append(o.remainings, info.Remaining)
+}
+
+func (o *recordingObserver) ObserveRateLimitWait(resourceType string, waited time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.waits == nil {
+		o.waits = make(map[string][]time.Duration)
+	}
+	o.waits[resourceType] = append(o.waits[resourceType], waited)
+}
+
+// TestObserver tests that attempts, retries and rate limits are reported to the observer
+func TestObserver(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-HubSpot-RateLimit-Remaining", strconv.Itoa(100-attempts))
+		if attempts < 3 {
+			respondJSON(w, 500, `{"status": "error", "message": "Server error"}`)
+			return
+		}
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	observer := &recordingObserver{}
+	client, err := NewClient(
+		WithBaseURL(server.URL),
+		WithRateLimitEnabled(false),
+		WithRetryMaxAttempts(3),
+		WithRetryBackoff(time.Millisecond, 10*time.Millisecond),
+		WithObserver(observer),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Do(context.Background(), NewRequest("GET", "/test").WithResourceType("contacts"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{500, 500, 200}, observer.statuses)
+	assert.Equal(t, []string{"contacts GET", "contacts GET", "contacts GET"}, observer.labels)
+	assert.Equal(t, []int{1, 2}, observer.retries)
+	assert.Equal(t, []int{99, 98, 97}, observer.remainings)
+}
+
+// TestRequestCapture tests that the serialized body is captured on every attempt
+func TestRequestCapture(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			respondJSON(w, 429, `{"status": "error", "message": "Rate limited"}`)
+			return
+		}
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	var methods, urls []string
+	var bodies [][]byte
+	client, err := NewClient(
+		WithBaseURL(server.URL),
+		WithRateLimitEnabled(false),
+		WithRetryBackoff(time.Millisecond, 10*time.Millisecond),
+		WithRequestCapture(func(method, url string, body []byte) {
+			methods = append(methods, method)
+			urls = append(urls, url)
+			bodies = append(bodies, body)
+		}),
+	)
+	require.NoError(t, err)
+
+	req := NewRequest("POST", "/test").WithBody(map[string]string{"name": "Acme"})
+	req.AddQueryParam("idProperty", "domain")
+	_, err = client.Do(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"POST", "POST"}, methods)
+	assert.Equal(t, server.URL+"/test?idProperty=domain", urls[0])
+	require.Len(t, bodies, 2)
+	assert.JSONEq(t, `{"name": "Acme"}`, string(bodies[0]))
+	assert.Equal(t, bodies[0], bodies[1])
+
+	bodies = nil
+	_, err = client.Do(context.Background(), NewRequest("GET", "/test"))
+	require.NoError(t, err)
+	require.Len(t, bodies, 1)
+	assert.Nil(t, bodies[0])
+}
+
+// TestResourceRateLimit tests client-wide and per-resource rate limits and reporting of rate limit waits
+func TestResourceRateLimit(t *testing.T) {
+	t.Run("Invalid limits", func(t *testing.T) {
+		_, err := NewClient(WithRateLimit(0, 10))
+		require.Error(t, err)
+		_, err = NewClient(WithResourceRateLimit(SearchResourceType, 5, 0))
+		require.Error(t, err)
+	})
+
+	t.Run("WithRateLimit sets rate and burst", func(t *testing.T) {
+		client, err := NewClient(WithRateLimit(2.5, 7))
+		require.NoError(t, err)
+		assert.Equal(t, 2.5, float64(client.rateLimiter.limiter.Limit()))
+		assert.Equal(t, 7, client.rateLimiter.limiter.Burst())
+	})
+
+	t.Run("Search requests are throttled separately", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(w, 200, `{}`)
+		}))
+		defer server.Close()
+
+		observer := &recordingObserver{}
+		client, err := NewClient(
+			WithBaseURL(server.URL),
+			WithRateLimitEnabled(true),
+			WithResourceRateLimit(SearchResourceType, 20, 1),
+			WithObserver(observer),
+		)
+		require.NoError(t, err)
+
+		start := time.Now()
+		for range 3 {
+			_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/contacts/1").WithResourceType("objects"))
+			require.NoError(t, err)
+		}
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+		assert.Empty(t, observer.waits)
+
+		for range 3 {
+			_, err := client.Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts/search").WithResourceType("objects"))
+			require.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+		assert.Len(t, observer.waits["objects"], 2)
+	})
+
+	t.Run("Cancelled wait returns tokens", func(t *testing.T) {
+		client, err := NewClient(WithRateLimitEnabled(true), WithResourceRateLimit("lists", 0.1, 1))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = client.rateLimiter.waitFor(ctx, NewRequest("GET", "/").WithResourceType("lists"))
+		require.NoError(t, err)
+
+		_, err = client.rateLimiter.waitFor(ctx, NewRequest("GET", "/").WithResourceType("lists"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.InDelta(t, 99, client.rateLimiter.limiter.Tokens(), 1)
+	})
+}
+
+// TestDefaultPageSize tests that paged requests without a limit get the default page size
+func TestDefaultPageSize(t *testing.T) {
+	var lastQuery url.Values
+	var lastBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastQuery = r.URL.Query()
+		lastBody, _ = io.ReadAll(r.Body)
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false), WithDefaultPageSize(100))
+	require.NoError(t, err)
+
+	t.Run("List request without limit", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/list").WithPaging())
+		require.NoError(t, err)
+		assert.Equal(t, "100", lastQuery.Get("limit"))
+	})
+
+	t.Run("List request with limit", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/list").WithPaging().AddQueryParam("limit", "5"))
+		require.NoError(t, err)
+		assert.Equal(t, "5", lastQuery.Get("limit"))
+	})
+
+	t.Run("Request that is not paged", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/object/1"))
+		require.NoError(t, err)
+		assert.False(t, lastQuery.Has("limit"))
+	})
+
+	t.Run("Search request with zero limit", func(t *testing.T) {
+		body := map[string]any{"query": "acme", "limit": 0}
+		_, err := client.Do(context.Background(), NewRequest("POST", "/search").WithPaging().WithBody(body))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"query": "acme", "limit": 100}`, string(lastBody))
+		assert.False(t, lastQuery.Has("limit"))
+	})
+
+	t.Run("Search request with limit", func(t *testing.T) {
+		body := map[string]any{"query": "acme", "limit": 10}
+		_, err := client.Do(context.Background(), NewRequest("POST", "/search").WithPaging().WithBody(body))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"query": "acme", "limit": 10}`, string(lastBody))
+	})
+
+	t.Run("Negative page size", func(t *testing.T) {
+		_, err := NewClient(WithDefaultPageSize(-1))
+		require.Error(t, err)
+	})
+}
+
+// TestUserAgent tests the default and configured User-Agent headers
+func TestUserAgent(t *testing.T) {
+	var lastUserAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastUserAgent = r.Header.Get("User-Agent")
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	t.Run("Default", func(t *testing.T) {
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false))
+		require.NoError(t, err)
+
+		_, err = client.Do(context.Background(), NewRequest("GET", "/test"))
+		require.NoError(t, err)
+		assert.Equal(t, "go-hubspot-sdk/"+Version, lastUserAgent)
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false),
+			WithUserAgent(DefaultUserAgent+" my-app/2.3"))
+		require.NoError(t, err)
+
+		_, err = client.Do(context.Background(), NewRequest("GET", "/test"))
+		require.NoError(t, err)
+		assert.Equal(t, DefaultUserAgent+" my-app/2.3", lastUserAgent)
+
+		_, err = client.Do(context.Background(), NewRequest("GET", "/test").AddHeader("User-Agent", "one-off/1.0"))
+		require.NoError(t, err)
+		assert.Equal(t, "one-off/1.0", lastUserAgent)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := NewClient(WithUserAgent(""))
+		assert.Error(t, err)
+	})
+}
+
+// TestDefaultProperties tests that configured properties are requested by object reads and lists that name none
+func TestDefaultProperties(t *testing.T) {
+	var lastQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastQuery = r.URL.Query()
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false),
+		WithDefaultProperties("contacts", []string{"email", "firstname", "lastname"}))
+	require.NoError(t, err)
+
+	t.Run("Read", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/contacts/1"))
+		require.NoError(t, err)
+		assert.Equal(t, "email,firstname,lastname", lastQuery.Get("properties"))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/contacts"))
+		require.NoError(t, err)
+		assert.Equal(t, "email,firstname,lastname", lastQuery.Get("properties"))
+	})
+
+	t.Run("Properties set by the call", func(t *testing.T) {
+		req := NewRequest("GET", "/crm/v3/objects/contacts/1").AddQueryParam("properties", "phone")
+		_, err := client.Do(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "phone", lastQuery.Get("properties"))
+	})
+
+	t.Run("Other object type", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/companies/1"))
+		require.NoError(t, err)
+		assert.False(t, lastQuery.Has("properties"))
+	})
+
+	t.Run("Not a read", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/contacts/1/associations/companies"))
+		require.NoError(t, err)
+		assert.False(t, lastQuery.Has("properties"))
+
+		_, err = client.Do(context.Background(), NewRequest("PATCH", "/crm/v3/objects/contacts/1").WithBody(map[string]any{}))
+		require.NoError(t, err)
+		assert.False(t, lastQuery.Has("properties"))
+	})
+}
+
+// TestDoStream tests streaming responses through the middleware chain
+func TestDoStream(t *testing.T) {
+	t.Run("Returns unread body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte("id,email\n1,a@example.com\n"))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithAccessToken("test-token"), WithCompression(true))
+		require.NoError(t, err)
+
+		resp, err := client.DoStream(context.Background(), NewRequest("GET", "/export"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "id,email\n1,a@example.com\n", string(body))
+	})
+
+	t.Run("Body outlives client timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("id,email\n"))
+			w.(http.Flusher).Flush()
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte("1,a@example.com\n"))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false), WithTimeout(50*time.Millisecond))
+		require.NoError(t, err)
+
+		resp, err := client.DoStream(context.Background(), NewRequest("GET", "/export"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "id,email\n1,a@example.com\n", string(body))
+	})
+
+	t.Run("Client timeout bounds response headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false), WithRetryEnabled(false),
+			WithTimeout(20*time.Millisecond))
+		require.NoError(t, err)
+
+		_, err = client.DoStream(context.Background(), NewRequest("GET", "/export"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(w, 404, `{"status": "error", "message": "Not found", "category": "OBJECT_NOT_FOUND"}`)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false))
+		require.NoError(t, err)
+
+		resp, err := client.DoStream(context.Background(), NewRequest("GET", "/export"))
+		require.Error(t, err)
+		assert.Nil(t, resp)
+
+		var hubspotErr *HubSpotError
+		require.ErrorAs(t, err, &hubspotErr)
+		assert.Equal(t, 404, hubspotErr.Status)
+	})
+
+	t.Run("Retries idempotent methods only", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			respondJSON(w, 500, `{"status": "error", "message": "Server error"}`)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(
+			WithBaseURL(server.URL),
+			WithRateLimitEnabled(false),
+			WithRetryMaxAttempts(3),
+			WithRetryBackoff(time.Millisecond, time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		_, err = client.DoStream(context.Background(), NewRequest("GET", "/export"))
+		require.Error(t, err)
+		assert.Equal(t, 3, attempts)
+
+		attempts = 0
+		_, err = client.DoStream(context.Background(), NewRequest("POST", "/export"))
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+// TestRetryNonIdempotent tests that POSTs that create records are only retried when safe
+func TestRetryNonIdempotent(t *testing.T) {
+	attempts := 0
+	status := 500
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		respondJSON(w, status, `{"status": "error", "message": "Server error"}`)
+	}))
+	defer server.Close()
+
+	newClient := func(opts ...Option) *Client {
+		opts = append([]Option{
+			WithBaseURL(server.URL),
+			WithRateLimitEnabled(false),
+			WithRetryMaxAttempts(3),
+			WithRetryBackoff(time.Millisecond, time.Millisecond),
+		}, opts...)
+		client, err := NewClient(opts...)
+		require.NoError(t, err)
+		return client
+	}
+	client := newClient()
+
+	tests := []struct {
+		name     string
+		req      *Request
+		status   int
+		attempts int
+	}{
+		{"Create is not retried", NewRequest("POST", "/crm/v3/objects/contacts"), 500, 1},
+		{"Batch create is not retried", NewRequest("POST", "/crm/v3/objects/contacts/batch/create"), 503, 1},
+		{"Create is retried after 429", NewRequest("POST", "/crm/v3/objects/contacts"), 429, 3},
+		{"Search is retried", NewRequest("POST", "/crm/v3/objects/contacts/search"), 500, 3},
+		{"Batch read is retried", NewRequest("POST", "/crm/v3/objects/contacts/batch/read"), 500, 3},
+		{"Retry-safe POST is retried", NewRequest("POST", "/crm/v3/objects/contacts").WithRetrySafe(), 500, 3},
+		{"PATCH is retried", NewRequest("PATCH", "/crm/v3/objects/contacts/1"), 500, 3},
+		{"DELETE is retried", NewRequest("DELETE", "/crm/v3/objects/contacts/1"), 500, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts = 0
+			status = tt.status
+			_, err := client.Do(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, tt.attempts, attempts)
+		})
+	}
+
+	t.Run("Create is retried when enabled", func(t *testing.T) {
+		attempts = 0
+		status = 500
+		_, err := newClient(WithRetryNonIdempotent(true)).Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts"))
+		require.Error(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+}
+
+// TestDryRun tests that dry-run mode captures writes without sending them while still sending GETs
+func TestDryRun(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		respondJSON(w, 200, `{}`)
+	}))
+	defer server.Close()
+
+	var captured []string
+	client, err := NewClient(WithBaseURL(server.URL), WithRateLimitEnabled(false), WithDryRun(true),
+		WithRequestCapture(func(method, url string, body []byte) {
+			captured = append(captured, method+" "+string(body))
+		}))
+	require.NoError(t, err)
+
+	t.Run("Write", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts").WithBody(map[string]string{"a": "b"}))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrDryRun)
+		assert.Empty(t, methods)
+		assert.Equal(t, []string{`POST {"a":"b"}`}, captured)
+
+		_, err = client.Do(context.Background(), NewRequest("DELETE", "/crm/v3/objects/contacts/1"))
+		assert.ErrorIs(t, err, ErrDryRun)
+		assert.Empty(t, methods)
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		_, err := client.Do(context.Background(), NewRequest("GET", "/crm/v3/objects/contacts"))
+		require.NoError(t, err)
+		_, err = client.Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts/search").WithBody(map[string]any{}))
+		require.NoError(t, err)
+		_, err = client.Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts/batch/read").WithBody(map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"GET", "POST", "POST"}, methods)
+
+		_, err = client.Do(context.Background(), NewRequest("POST", "/crm/v3/objects/contacts/batch/update").WithBody(map[string]any{}))
+		assert.ErrorIs(t, err, ErrDryRun)
+	})
 }
@@ -1,18 +1,55 @@
 package client
 
 import (
+	"fmt"
 	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// Version is the version of the SDK, sent in DefaultUserAgent
+const Version = "1.0"
+
+// DefaultUserAgent is the User-Agent header sent unless WithUserAgent sets another
+const DefaultUserAgent = "go-hubspot-sdk/" + Version
+
 // Config holds all configuration for the HubSpot API client
 type Config struct {
 	AccessToken string
 	BaseURL     string
 	Timeout     time.Duration
+	Compression bool
+	UserAgent   string
 	RateLimit   RateLimitConfig
 	Retry       RetryConfig
 	Logger      *slog.Logger
+
+	// LogSampleRate is the fraction of successful requests that are logged, between 0 and 1
+	LogSampleRate float64
+
+	// Observer receives request and retry metrics; nil disables observation
+	Observer Observer
+
+	// DefaultPageSize is the limit sent with list and search requests that don't set one; zero leaves HubSpot's default
+	DefaultPageSize int
+
+	// RequestCapture receives each outgoing request's method, URL and serialized body; nil disables capture
+	RequestCapture func(method, url string, body []byte)
+
+	// DryRun stops requests that write from being sent; they return ErrDryRun instead
+	DryRun bool
+
+	// CreateDefaults holds default property values per object type applied on create
+	CreateDefaults map[string]map[string]string
+
+	// DefaultProperties holds the properties requested per object type by reads and lists that don't name their own
+	DefaultProperties map[string][]string
+
+	// envPrefix is set by WithDeadlineFromEnv
+	envPrefix string
 }
 
 // RateLimitConfig configures rate limiting behavior
@@ -20,14 +57,43 @@ type RateLimitConfig struct {
 	MaxBurst   int
 	DailyLimit int
 	Enabled    bool
+
+	// PerSecond is the sustained request rate; zero means MaxBurst requests per 10 seconds, HubSpot's usual window
+	PerSecond float64
+
+	// Resources holds additional limits keyed by resource type, applied on top of the client-wide limit
+	Resources map[string]ResourceRateLimit
 }
 
+// ResourceRateLimit is a token bucket for the requests of one resource type
+type ResourceRateLimit struct {
+	PerSecond float64
+	Burst     int
+}
+
+// SearchResourceType is the resource type key under which WithResourceRateLimit limits every search request
+//
+// HubSpot limits the search endpoints per account, across object types, and more tightly than other endpoints, so
+// requests to any path ending in /search are also subject to the limit registered for this key
+const SearchResourceType = "search"
+
 // RetryConfig configures retry behavior
 type RetryConfig struct {
 	MaxAttempts    int
 	InitialBackoff time.Duration
 	MaxBackoff     time.Duration
 	Enabled        bool
+
+	// Jitter randomizes each backoff between 0 and the computed delay so concurrent clients don't retry in lockstep
+	Jitter bool
+
+	// MaxElapsed bounds the total time spent on a request and its retries; zero means no bound
+	MaxElapsed time.Duration
+
+	// NonIdempotent retries every request on server errors, including POSTs that create records. When false, the
+	// default, a POST that isn't retry-safe (see Request.RetrySafe) is only retried after a 429, which HubSpot returns
+	// before processing the request, so a create that failed after being processed isn't repeated as a duplicate
+	NonIdempotent bool
 }
 
 // Option is a functional option for configuring the Client
@@ -36,8 +102,9 @@ type Option func(*Config) error
 // NewConfig creates a Config with sensible defaults
 func NewConfig() *Config {
 	return &Config{
-		BaseURL: "https://api.hubapi.com",
-		Timeout: 30 * time.Second,
+		BaseURL:   "https://api.hubapi.com",
+		Timeout:   30 * time.Second,
+		UserAgent: DefaultUserAgent,
 		RateLimit: RateLimitConfig{
 			MaxBurst:   100,
 			DailyLimit: 250000,
@@ -48,8 +115,10 @@ func NewConfig() *Config {
 			InitialBackoff: 1 * time.Second,
 			MaxBackoff:     30 * time.Second,
 			Enabled:        true,
+			Jitter:         true,
 		},
-		Logger: slog.Default(),
+		Logger:        slog.Default(),
+		LogSampleRate: 1,
 	}
 }
 
@@ -62,6 +131,8 @@ func WithAccessToken(token string) Option {
 }
 
 // WithBaseURL sets the API base URL (useful for testing)
+//
+// The URL must include the scheme, e.g. "http://localhost:8080". See WithRegion for selecting a HubSpot region
 func WithBaseURL(url string) Option {
 	return func(cfg *Config) error {
 		cfg.BaseURL = url
@@ -69,7 +140,54 @@ func WithBaseURL(url string) Option {
 	}
 }
 
+// WithUserAgent sets the User-Agent header of every request, replacing DefaultUserAgent
+//
+// To identify an application while keeping the SDK attribution, append to the default:
+//
+//	client.WithUserAgent(client.DefaultUserAgent + " my-app/2.3")
+//
+// A User-Agent header set on an individual request takes precedence. An empty userAgent is rejected
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *Config) error {
+		if userAgent == "" {
+			return fmt.Errorf("user agent must not be empty")
+		}
+		cfg.UserAgent = userAgent
+		return nil
+	}
+}
+
+// HubSpot data residency regions accepted by WithRegion
+const (
+	RegionNA1 = "na1" // North America, the default
+	RegionEU1 = "eu1" // European Union
+)
+
+// regionBaseURLs maps each region to its API host
+var regionBaseURLs = map[string]string{
+	RegionNA1: "https://api.hubapi.com",
+	RegionEU1: "https://api-eu1.hubapi.com",
+}
+
+// WithRegion sets the API host for the HubSpot data residency region the account is hosted in
+//
+// WithRegion and WithBaseURL both set the base URL, so whichever is passed last to NewClient wins. Use WithRegion to
+// select a production region and WithBaseURL for anything else, such as a test server or proxy
+func WithRegion(region string) Option {
+	return func(cfg *Config) error {
+		baseURL, ok := regionBaseURLs[region]
+		if !ok {
+			return fmt.Errorf("unknown HubSpot region %q", region)
+		}
+		cfg.BaseURL = baseURL
+		return nil
+	}
+}
+
 // WithTimeout sets the request timeout
+//
+// The timeout applies to every HTTP attempt. Use a context deadline on an individual call to fail faster than this;
+// see Client.Do for how the two interact
 func WithTimeout(timeout time.Duration) Option {
 	return func(cfg *Config) error {
 		cfg.Timeout = timeout
@@ -77,6 +195,14 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithCompression requests gzip-compressed responses and transparently decompresses them
+func WithCompression(enabled bool) Option {
+	return func(cfg *Config) error {
+		cfg.Compression = enabled
+		return nil
+	}
+}
+
 // WithRateLimitMaxBurst sets the maximum burst for rate limiting
 func WithRateLimitMaxBurst(burst int) Option {
 	return func(cfg *Config) error {
@@ -85,6 +211,37 @@ func WithRateLimitMaxBurst(burst int) Option {
 	}
 }
 
+// WithRateLimit sets the client-wide sustained request rate and the burst allowed above it
+//
+// It replaces the default of MaxBurst requests per 10 seconds
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(cfg *Config) error {
+		if perSecond <= 0 || burst < 1 {
+			return fmt.Errorf("rate limit needs a positive rate and burst, got %v/s with burst %d", perSecond, burst)
+		}
+		cfg.RateLimit.PerSecond = perSecond
+		cfg.RateLimit.MaxBurst = burst
+		return nil
+	}
+}
+
+// WithResourceRateLimit adds a rate limit for requests whose resource type is resourceType
+//
+// Requests wait for both this limit and the client-wide one. Use SearchResourceType to throttle all search requests
+// together, e.g. WithResourceRateLimit(SearchResourceType, 5, 5) for HubSpot's five searches per second
+func WithResourceRateLimit(resourceType string, perSecond float64, burst int) Option {
+	return func(cfg *Config) error {
+		if perSecond <= 0 || burst < 1 {
+			return fmt.Errorf("rate limit for %s needs a positive rate and burst, got %v/s with burst %d", resourceType, perSecond, burst)
+		}
+		if cfg.RateLimit.Resources == nil {
+			cfg.RateLimit.Resources = make(map[string]ResourceRateLimit)
+		}
+		cfg.RateLimit.Resources[resourceType] = ResourceRateLimit{PerSecond: perSecond, Burst: burst}
+		return nil
+	}
+}
+
 // WithRateLimitDailyLimit sets the daily rate limit
 func WithRateLimitDailyLimit(limit int) Option {
 	return func(cfg *Config) error {
@@ -126,6 +283,43 @@ func WithRetryBackoff(initial, max time.Duration) Option {
 	}
 }
 
+// WithRetryMaxElapsed bounds the total time a request may spend retrying, regardless of the per-attempt backoff
+//
+// Once the next backoff would end past d from the first attempt, no more attempts are made and the last error is
+// returned. A d of zero, the default, leaves retries bounded only by MaxAttempts
+func WithRetryMaxElapsed(d time.Duration) Option {
+	return func(cfg *Config) error {
+		if d < 0 {
+			return fmt.Errorf("retry max elapsed must not be negative, got %s", d)
+		}
+		cfg.Retry.MaxElapsed = d
+		return nil
+	}
+}
+
+// WithRetryNonIdempotent enables/disables retrying POSTs that aren't retry-safe on server errors
+//
+// It is off by default, so a create that times out or fails with a 5xx after HubSpot processed it isn't sent again.
+// GET, PUT, PATCH and DELETE requests, searches, batch reads and requests marked with Request.WithRetrySafe are
+// retried either way, and every request is retried after a 429
+func WithRetryNonIdempotent(enabled bool) Option {
+	return func(cfg *Config) error {
+		cfg.Retry.NonIdempotent = enabled
+		return nil
+	}
+}
+
+// WithRetryJitter enables/disables full jitter on retry backoff
+//
+// Jitter is on by default. Disabling it makes backoff exactly InitialBackoff * 2^attempt, capped at MaxBackoff,
+// which is useful for deterministic tests
+func WithRetryJitter(enabled bool) Option {
+	return func(cfg *Config) error {
+		cfg.Retry.Jitter = enabled
+		return nil
+	}
+}
+
 // WithLogger sets the logger for the client
 func WithLogger(logger *slog.Logger) Option {
 	return func(cfg *Config) error {
@@ -133,3 +327,173 @@ func WithLogger(logger *slog.Logger) Option {
 		return nil
 	}
 }
+
+// WithObserver reports every HTTP attempt and retry to observer, e.g. to record metrics
+func WithObserver(observer Observer) Option {
+	return func(cfg *Config) error {
+		cfg.Observer = observer
+		return nil
+	}
+}
+
+// WithDefaultPageSize sets the page size of list and search requests that don't set their own limit
+//
+// List requests get it as the limit query parameter and search requests as the limit field of the body, unless the
+// call passes its own WithLimit or a non-zero Limit. HubSpot accepts up to 100 on most list endpoints and up to 200
+// on search, and rejects larger limits
+func WithDefaultPageSize(n int) Option {
+	return func(cfg *Config) error {
+		if n < 0 {
+			return fmt.Errorf("default page size must not be negative, got %d", n)
+		}
+		cfg.DefaultPageSize = n
+		return nil
+	}
+}
+
+// WithRequestCapture calls fn with the method, full URL and exact JSON body of every request just before it is sent
+//
+// fn is called once per attempt, so retried requests are captured again with the same body. body is nil for
+// requests without one and is a copy, so fn may keep it. Headers, including the access token, are not passed
+func WithRequestCapture(fn func(method, url string, body []byte)) Option {
+	return func(cfg *Config) error {
+		cfg.RequestCapture = fn
+		return nil
+	}
+}
+
+// WithDryRun stops every request that writes from reaching HubSpot when enabled
+//
+// Skipped requests are still logged and passed to the WithRequestCapture hook, then fail with an error wrapping
+// ErrDryRun, so a script can show exactly what it would change. Reads are sent as usual so the script can look up
+// the data it decides on: GETs, and POSTs to search and batch read endpoints
+func WithDryRun(enabled bool) Option {
+	return func(cfg *Config) error {
+		cfg.DryRun = enabled
+		return nil
+	}
+}
+
+// WithLogSampling logs only the given fraction of successful requests to reduce log volume
+//
+// rate must be between 0 and 1. Errors and retried attempts are always logged regardless of the rate
+func WithLogSampling(rate float64) Option {
+	return func(cfg *Config) error {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("log sample rate must be between 0 and 1, got %v", rate)
+		}
+		cfg.LogSampleRate = rate
+		return nil
+	}
+}
+
+// WithCreateDefaults sets default property values merged into create requests for objectType
+//
+// Defaults never override a property that is explicitly set on the create input
+func WithCreateDefaults(objectType string, defaults map[string]string) Option {
+	return func(cfg *Config) error {
+		if cfg.CreateDefaults == nil {
+			cfg.CreateDefaults = make(map[string]map[string]string)
+		}
+		merged := make(map[string]string, len(defaults))
+		for k, v := range cfg.CreateDefaults[objectType] {
+			merged[k] = v
+		}
+		for k, v := range defaults {
+			merged[k] = v
+		}
+		cfg.CreateDefaults[objectType] = merged
+		return nil
+	}
+}
+
+// WithDefaultProperties sets the properties returned by reads and lists of objectType that don't request their own
+//
+// The properties are sent on GET requests to /crm/v3/objects/{objectType} and /crm/v3/objects/{objectType}/{id}
+// without a properties query parameter, so a call passing WithProperties gets exactly the properties it names. The
+// objects client's batch reads, including ReadObject calls batched by its WithAutoBatch, use them too when they
+// name no properties; the batch reads of the per-object packages such as companies don't.
+// objectType must be written as in request paths, e.g. "contacts", or the name or ID a custom object is read with.
+// Calling it again for the same objectType replaces its defaults
+func WithDefaultProperties(objectType string, properties []string) Option {
+	return func(cfg *Config) error {
+		if cfg.DefaultProperties == nil {
+			cfg.DefaultProperties = make(map[string][]string)
+		}
+		cfg.DefaultProperties[objectType] = slices.Clone(properties)
+		return nil
+	}
+}
+
+// WithDeadlineFromEnv reads timeout and retry settings from environment variables when the client is created
+//
+// Environment values override the defaults but never options passed explicitly to NewClient, regardless of
+// option order. The variables read, with prefix "HUBSPOT" (used when prefix is empty), are:
+//
+//	HUBSPOT_TIMEOUT                request timeout as a duration, e.g. "45s"
+//	HUBSPOT_MAX_RETRIES            retries after the first attempt, e.g. "3" for up to 4 attempts; "0" disables them
+//	HUBSPOT_RETRY_ENABLED          "true" or "false"
+//	HUBSPOT_RETRY_INITIAL_BACKOFF  duration, e.g. "500ms"
+//	HUBSPOT_RETRY_MAX_BACKOFF      duration, e.g. "1m"
+//	HUBSPOT_RETRY_MAX_ELAPSED      duration, e.g. "2m"
+//
+// NewClient returns an error if any of these are set to a malformed value
+func WithDeadlineFromEnv(prefix string) Option {
+	return func(cfg *Config) error {
+		if prefix == "" {
+			prefix = "HUBSPOT"
+		}
+		cfg.envPrefix = prefix
+		return nil
+	}
+}
+
+// applyEnv overrides cfg with the settings found in the environment under prefix
+func applyEnv(cfg *Config, prefix string) error {
+	if err := envDuration(prefix+"_TIMEOUT", &cfg.Timeout); err != nil {
+		return err
+	}
+	if err := envDuration(prefix+"_RETRY_INITIAL_BACKOFF", &cfg.Retry.InitialBackoff); err != nil {
+		return err
+	}
+	if err := envDuration(prefix+"_RETRY_MAX_BACKOFF", &cfg.Retry.MaxBackoff); err != nil {
+		return err
+	}
+	if err := envDuration(prefix+"_RETRY_MAX_ELAPSED", &cfg.Retry.MaxElapsed); err != nil {
+		return err
+	}
+
+	if value, ok := os.LookupEnv(prefix + "_MAX_RETRIES"); ok {
+		retries, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || retries < 0 {
+			return fmt.Errorf("invalid %s_MAX_RETRIES %q: expected a non-negative integer", prefix, value)
+		}
+		// MaxAttempts counts the first attempt too
+		cfg.Retry.MaxAttempts = retries + 1
+	}
+
+	if value, ok := os.LookupEnv(prefix + "_RETRY_ENABLED"); ok {
+		enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("invalid %s_RETRY_ENABLED %q: expected true or false", prefix, value)
+		}
+		cfg.Retry.Enabled = enabled
+	}
+
+	return nil
+}
+
+// envDuration parses the duration in the environment variable name into dst, if set
+func envDuration(name string, dst *time.Duration) error {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || duration <= 0 {
+		return fmt.Errorf("invalid %s %q: expected a positive duration like \"30s\"", name, value)
+	}
+	*dst = duration
+	return nil
+}
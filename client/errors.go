@@ -2,12 +2,29 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// Error categories HubSpot sets in the category field of error responses
+const (
+	CategoryValidation     = "VALIDATION_ERROR"
+	CategoryObjectNotFound = "OBJECT_NOT_FOUND"
+	CategoryConflict       = "CONFLICT"
+	CategoryRateLimits     = "RATE_LIMITS"
+)
+
+// ErrDryRun is wrapped by the error returned for requests that WithDryRun kept from being sent
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// ErrTooManyPages is wrapped by the error returned when following a paging cursor doesn't end, because a page repeats
+// the previous cursor or a page cap is reached
+var ErrTooManyPages = errors.New("too many pages")
+
 // HubSpotError stores the information returned from Hubspot Errors. Implements the error interface.
 type HubSpotError struct {
 	Status        int
@@ -17,12 +34,31 @@ type HubSpotError struct {
 	PolicyName    string // "DAILY" or "TEN_SECONDLY_ROLLING"
 	CorrelationID string
 
+	// Errors holds the per-field details HubSpot lists in the errors array of a response, mostly on 400s
+	Errors []ErrorDetail
+
 	// Used to deteremine if request should retry
 	IsRetryable bool
 	RetryAfter  time.Duration
 	RawBody     string
 }
 
+// ErrorDetail is one entry of the errors array of a HubSpot error response
+type ErrorDetail struct {
+	Message     string              `json:"message"`
+	In          string              `json:"in"` // The field or property the error is about, when HubSpot names one
+	Code        string              `json:"code"`
+	SubCategory any                 `json:"subCategory"` // Usually a string such as "INVALID_EMAIL"
+	Context     map[string][]string `json:"context"`
+}
+
+func (d ErrorDetail) String() string {
+	if d.In != "" {
+		return fmt.Sprintf("%s: %s", d.In, d.Message)
+	}
+	return d.Message
+}
+
 // Functions to:
 // - Parse error from response
 // - Determine if retryable (429s with TEN_SECONDLY are retryable, DAILYs probably aren't)
@@ -31,11 +67,95 @@ type HubSpotError struct {
 // Error inplements the error interface
 func (e *HubSpotError) Error() string {
 	if e.Message != "" {
-		return fmt.Sprintf("HubSpot API error: %s (type: %s, status: %d)", e.Message, e.ErrorType, e.Status)
+		msg := fmt.Sprintf("HubSpot API error: %s (type: %s, status: %d)", e.Message, e.ErrorType, e.Status)
+		if len(e.Errors) > 0 {
+			details := make([]string, len(e.Errors))
+			for i, detail := range e.Errors {
+				details[i] = detail.String()
+			}
+			msg += ": " + strings.Join(details, "; ")
+		}
+		return msg
 	}
 	return fmt.Sprintf("HubSpot API error: status %d", e.Status)
 }
 
+// IsValidation reports whether HubSpot rejected the request's input
+func (e *HubSpotError) IsValidation() bool {
+	return e != nil && e.Category == CategoryValidation
+}
+
+// IsRateLimited reports whether the request was rejected by a HubSpot or client-side rate limit
+func (e *HubSpotError) IsRateLimited() bool {
+	return e != nil && (e.Status == http.StatusTooManyRequests || e.Category == CategoryRateLimits)
+}
+
+// IsNotFound reports whether the requested resource does not exist
+func (e *HubSpotError) IsNotFound() bool {
+	return e != nil && (e.Status == http.StatusNotFound || e.Category == CategoryObjectNotFound)
+}
+
+// IsConflict reports whether the request conflicts with an existing resource, e.g. a duplicate unique value
+func (e *HubSpotError) IsConflict() bool {
+	return e != nil && (e.Status == http.StatusConflict || e.Category == CategoryConflict)
+}
+
+// AsHubSpotError finds the *HubSpotError in err's chain
+//
+// Package-specific errors such as objects.ObjectNotFoundError unwrap to the HubSpotError they were built from, so
+// AsHubSpotError(err) followed by one of the Is* predicates works on any error returned by the SDK
+func AsHubSpotError(err error) (*HubSpotError, bool) {
+	var hubspotErr *HubSpotError
+	if errors.As(err, &hubspotErr) {
+		return hubspotErr, true
+	}
+	return nil, false
+}
+
+// NotFoundError is returned by the single-read methods of the CRM clients when the requested object doesn't exist
+//
+// It is the same type for every object type, so one errors.As check handles not-found errors from any package.
+// Package-specific not-found errors, such as objects.ObjectNotFoundError, unwrap to it, and it unwraps to the
+// HubSpotError it was built from
+type NotFoundError struct {
+	ObjectType string
+	ObjectID   string
+	Original   *HubSpotError
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ObjectID == "" {
+		return fmt.Sprintf("%s not found", e.ObjectType)
+	}
+	return fmt.Sprintf("%s %s not found", e.ObjectType, e.ObjectID)
+}
+
+// Unwrap returns the HubSpot error this error was built from
+func (e *NotFoundError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
+// ParseNotFoundError returns err as a *NotFoundError for objectType and objectID if it is a not-found HubSpot error,
+// and err unchanged otherwise
+func ParseNotFoundError(err error, objectType, objectID string) error {
+	if hubspotErr, ok := err.(*HubSpotError); ok && hubspotErr.IsNotFound() {
+		return &NotFoundError{ObjectType: objectType, ObjectID: objectID, Original: hubspotErr}
+	}
+	return err
+}
+
+// RequiredFieldError is returned before a request is sent when a required field of its input is empty
+type RequiredFieldError struct {
+	Field string
+}
+
+func (e *RequiredFieldError) Error() string {
+	return fmt.Sprintf("field '%s' is required but is empty", e.Field)
+}
+
 // ParseHubSpotError parses a response into a HubSpotError
 func ParseHubSpotError(statusCode int, body []byte, headers http.Header) *HubSpotError {
 	err := &HubSpotError{
@@ -72,6 +192,14 @@ func ParseHubSpotError(statusCode int, body []byte, headers http.Header) *HubSpo
 		err.CorrelationID = hubspotResp.CorrelationID
 	}
 
+	// Decoded on its own so that an unexpected detail shape doesn't lose the fields above
+	var details struct {
+		Errors []ErrorDetail `json:"errors"`
+	}
+	if unmarshalErr := json.Unmarshal(body, &details); unmarshalErr == nil {
+		err.Errors = details.Errors
+	}
+
 	// Determine if retryable
 	err.IsRetryable = isRetryableStatus(statusCode, err.PolicyName)
 
@@ -0,0 +1,60 @@
+package client
+
+import "time"
+
+// Observer receives request metrics from the client, e.g. to export them to Prometheus
+//
+// resourceType is the value set with Request.WithResourceType, which makes it a natural metric label. Observers are
+// called synchronously from the request path and concurrently from every goroutine using the client, so
+// implementations must be safe for concurrent use and should return quickly
+type Observer interface {
+	// ObserveRequest is called once per HTTP attempt, including retried attempts. status is 0 when no response was
+	// received, e.g. on a network error or timeout
+	ObserveRequest(resourceType, method string, status int, dur time.Duration)
+
+	// ObserveRetry is called before each retried attempt; attempt starts at 1 for the first retry
+	ObserveRetry(resourceType string, attempt int)
+}
+
+// RateLimitObserver is an optional extension of Observer that also receives the rate limit headers of each response
+//
+// If the Observer passed to WithObserver implements it, ObserveRateLimit is called after every attempt that
+// received a response
+type RateLimitObserver interface {
+	ObserveRateLimit(resourceType string, info RateLimitInfo)
+}
+
+// RateLimitWaitObserver is an optional extension of Observer that is told when the client-side rate limiter held a
+// request back
+//
+// If the Observer passed to WithObserver implements it, ObserveRateLimitWait is called with how long a request
+// waited for the client-wide or per-resource limit before it was sent. Requests that didn't wait aren't reported
+type RateLimitWaitObserver interface {
+	ObserveRateLimitWait(resourceType string, waited time.Duration)
+}
+
+// wrapObserveMiddleware reports each HTTP attempt to the configured Observer
+func (c *Client) wrapObserveMiddleware(next Handler) Handler {
+	return func(req *Request) (*Response, error) {
+		observer := c.config.Observer
+		if observer == nil {
+			return next(req)
+		}
+
+		start := time.Now()
+		resp, err := next(req)
+		dur := time.Since(start)
+
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		observer.ObserveRequest(req.ResourceType, req.Method, status, dur)
+
+		if rateLimitObserver, ok := observer.(RateLimitObserver); ok && resp != nil {
+			rateLimitObserver.ObserveRateLimit(req.ResourceType, resp.RateLimit)
+		}
+
+		return resp, err
+	}
+}
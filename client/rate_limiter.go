@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -9,13 +11,31 @@ import (
 )
 
 type RateLimiter struct {
-	limiter *rate.Limiter // golang.org/x/time/rate
-	mu      sync.RWMutex
+	limiter   *rate.Limiter // golang.org/x/time/rate
+	resources map[string]*rate.Limiter
+	mu        sync.RWMutex
 
 	// Track daily usage (resets at account's midnight)
 	dailyLimit     int
 	dailyRemaining int
 	dailyResetTime time.Time
+
+	// Daily quota remaining per TenantKey of requests made with ContextWithAccessToken
+	tenantDailyRemaining map[string]int
+
+	// Last rate limit values reported by HubSpot
+	lastSeen  RateLimitInfo
+	updatedAt time.Time
+}
+
+// RateLimitSnapshot is a point-in-time view of the rate limit budget last reported by HubSpot
+type RateLimitSnapshot struct {
+	Max            int // Requests allowed in the rolling window
+	Remaining      int // Requests remaining in the rolling window
+	IntervalMs     int // Rolling window length in milliseconds
+	DailyLimit     int
+	DailyRemaining int
+	UpdatedAt      time.Time // Zero if no response has been seen yet
 }
 
 // NewRateLimiter manages rate limiting for API requests
@@ -31,18 +51,125 @@ func NewRateLimiter(maxBurst int) *RateLimiter {
 	}
 }
 
+// newConfiguredRateLimiter creates a rate limiter from cfg, including its per-resource limits
+func newConfiguredRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	rl := NewRateLimiter(cfg.MaxBurst)
+	if cfg.PerSecond > 0 {
+		rl.limiter.SetLimit(rate.Limit(cfg.PerSecond))
+	}
+
+	rl.resources = make(map[string]*rate.Limiter, len(cfg.Resources))
+	for resourceType, limit := range cfg.Resources {
+		rl.resources[resourceType] = rate.NewLimiter(rate.Limit(limit.PerSecond), limit.Burst)
+	}
+	return rl
+}
+
 // Wait blocks until a token is available for use
 func (rl *RateLimiter) Wait(ctx context.Context) error {
 	return rl.limiter.Wait(ctx)
 }
 
+// waitFor blocks until req may be sent under the client-wide limit and any limit for its resource type, and returns
+// how long it waited
+//
+// Tokens are reserved from every applicable limiter up front and returned if ctx ends first, so a cancelled request
+// doesn't use up budget
+func (rl *RateLimiter) waitFor(ctx context.Context, req *Request) (time.Duration, error) {
+	limiters := []*rate.Limiter{rl.limiter}
+	if limiter, ok := rl.resources[req.ResourceType]; ok {
+		limiters = append(limiters, limiter)
+	}
+	if limiter, ok := rl.resources[SearchResourceType]; ok && req.ResourceType != SearchResourceType && strings.HasSuffix(req.Path, "/search") {
+		limiters = append(limiters, limiter)
+	}
+
+	now := time.Now()
+	var delay time.Duration
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	cancelAll := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	for _, limiter := range limiters {
+		r := limiter.ReserveN(now, 1)
+		if !r.OK() {
+			cancelAll()
+			return 0, fmt.Errorf("rate limit burst is too small to send a request")
+		}
+		reservations = append(reservations, r)
+		delay = max(delay, r.DelayFrom(now))
+	}
+
+	if delay == 0 {
+		return 0, nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+		cancelAll()
+		return 0, fmt.Errorf("rate limit wait of %s would exceed the context deadline: %w", delay, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		cancelAll()
+		return 0, ctx.Err()
+	}
+}
+
 // UpdateFromResponse updates the rate limiter state from response headers
 func (rl *RateLimiter) UpdateFromResponse(resp *Response) {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	rl.dailyRemaining = resp.RateLimit.DailyRemaining
-	rl.dailyLimit = resp.RateLimit.DailyLimit
+	// Daily headers are only sent to some app types; keep the current quota when they're absent
+	if resp.RateLimit.DailyLimit > 0 {
+		rl.dailyRemaining = resp.RateLimit.DailyRemaining
+		rl.dailyLimit = resp.RateLimit.DailyLimit
+	}
+	rl.lastSeen = resp.RateLimit
+	rl.updatedAt = time.Now()
+}
+
+// updateFromResponseFor updates the rate limiter state from a response to a request for tenant, as returned by
+// TenantKey
+//
+// The daily quota of another account's token is tracked separately so it never blocks the client's own requests, and
+// its rate limit headers are left out of Snapshot
+func (rl *RateLimiter) updateFromResponseFor(tenant string, resp *Response) {
+	if tenant == "" {
+		rl.UpdateFromResponse(resp)
+		return
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if resp.RateLimit.DailyLimit > 0 {
+		if rl.tenantDailyRemaining == nil {
+			rl.tenantDailyRemaining = make(map[string]int)
+		}
+		rl.tenantDailyRemaining[tenant] = resp.RateLimit.DailyRemaining
+	}
+}
+
+// Snapshot returns the rate limit values from the most recent response to a request made with the client's own token
+func (rl *RateLimiter) Snapshot() RateLimitSnapshot {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	return RateLimitSnapshot{
+		Max:            rl.lastSeen.Max,
+		Remaining:      rl.lastSeen.Remaining,
+		IntervalMs:     rl.lastSeen.IntervalMs,
+		DailyLimit:     rl.lastSeen.DailyLimit,
+		DailyRemaining: rl.lastSeen.DailyRemaining,
+		UpdatedAt:      rl.updatedAt,
+	}
 }
 
 // CheckDailyLimit returns true if daily quota is available
@@ -53,6 +180,19 @@ func (rl *RateLimiter) CheckDailyLimit() bool {
 	return rl.dailyRemaining > 0
 }
 
+// checkDailyLimitFor returns true if tenant, as returned by TenantKey, has daily quota available
+func (rl *RateLimiter) checkDailyLimitFor(tenant string) bool {
+	if tenant == "" {
+		return rl.CheckDailyLimit()
+	}
+
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	remaining, ok := rl.tenantDailyRemaining[tenant]
+	return !ok || remaining > 0
+}
+
 // GetDailyLimit returns the current daily limit received from the API response
 func (rl *RateLimiter) GetDailyLimit() int {
 	rl.mu.RLock()
@@ -1,6 +1,10 @@
 package client
 
-import "context"
+import (
+	"context"
+	"slices"
+	"strings"
+)
 
 type Request struct {
 	Method      string
@@ -9,10 +13,26 @@ type Request struct {
 	QueryParams map[string]string
 	Headers     map[string]string
 
+	// RepeatedQueryParams holds parameters sent once per value, e.g. listIds=1&listIds=2, next to QueryParams
+	RepeatedQueryParams map[string][]string
+
 	// Metadata for middleware
 	ResourceType string
 	RetryCount   int
 
+	// Paged marks list and search requests, which get the client's default page size when they set no limit
+	Paged bool
+
+	// RetrySafe marks a POST that can be retried after a server error without side effects, e.g. one sent with an
+	// idempotency key. Other methods, searches and batch reads are always retry-safe
+	RetrySafe bool
+
+	// stream leaves a successful response body unread for DoStream
+	stream bool
+
+	// Metadata holds client-side settings from request options that aren't sent to HubSpot
+	Metadata map[string]any
+
 	// Context for timeouts/cancellation
 	Context context.Context
 }
@@ -23,6 +43,7 @@ func NewRequest(method, path string) *Request {
 		Path:        path,
 		QueryParams: make(map[string]string),
 		Headers:     make(map[string]string),
+		Metadata:    make(map[string]any),
 		Context:     context.Background(),
 	}
 }
@@ -37,6 +58,18 @@ func (r *Request) WithResourceType(resourceType string) *Request {
 	return r
 }
 
+// WithPaging marks the request as reading a page of results, see Paged
+func (r *Request) WithPaging() *Request {
+	r.Paged = true
+	return r
+}
+
+// WithRetrySafe marks a POST as safe to retry after a server error; see RetrySafe
+func (r *Request) WithRetrySafe() *Request {
+	r.RetrySafe = true
+	return r
+}
+
 func (r *Request) WithBody(body any) *Request {
 	r.Body = body
 	return r
@@ -47,7 +80,49 @@ func (r *Request) AddQueryParam(key, value string) *Request {
 	return r
 }
 
+// AddRepeatedQueryParam adds values to the parameter key, which is sent once per value; see RepeatedQueryParams
+func (r *Request) AddRepeatedQueryParam(key string, values ...string) *Request {
+	if r.RepeatedQueryParams == nil {
+		r.RepeatedQueryParams = make(map[string][]string)
+	}
+	r.RepeatedQueryParams[key] = append(r.RepeatedQueryParams[key], values...)
+	return r
+}
+
+// AppendQueryParam adds the comma-separated values in value to the list in key, keeping values already there
+//
+// Use it for list parameters such as properties, so that repeated options accumulate instead of replacing each other.
+// Empty values and duplicates are skipped
+func (r *Request) AppendQueryParam(key, value string) *Request {
+	var values []string
+	if existing := r.QueryParams[key]; existing != "" {
+		values = strings.Split(existing, ",")
+	}
+	for _, v := range strings.Split(value, ",") {
+		if v != "" && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	if len(values) > 0 {
+		r.QueryParams[key] = strings.Join(values, ",")
+	}
+	return r
+}
+
 func (r *Request) AddHeader(key, value string) *Request {
 	r.Headers[key] = value
 	return r
 }
+
+func (r *Request) SetMetadata(key string, value any) *Request {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]any)
+	}
+	r.Metadata[key] = value
+	return r
+}
+
+func (r *Request) GetMetadata(key string) (any, bool) {
+	value, ok := r.Metadata[key]
+	return value, ok
+}
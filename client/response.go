@@ -16,6 +16,9 @@ type Response struct {
 
 	// HubSpot error from HubSpot (if applicable)
 	HubSpotError *HubSpotError
+
+	// Raw is the live HTTP response of a successful DoStream call, with its body unread. It is nil for Do
+	Raw *http.Response
 }
 
 type RateLimitInfo struct {
@@ -0,0 +1,27 @@
+package companies
+
+import "context"
+
+// API is the set of companies client methods, implemented by *Client
+//
+// Code that calls the companies API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateCompany(ctx context.Context, input *CreateCompanyInput) (*Company, error)
+	GetCompany(ctx context.Context, companyID string, opts ...CompanyOption) (*Company, error)
+	UpdateCompany(ctx context.Context, companyID string, input *UpdateCompanyInput) (*Company, error)
+	ArchiveCompany(ctx context.Context, companyID string) error
+	ListCompanies(ctx context.Context, opts ...CompanyOption) (*ListCompaniesResponse, error)
+	ForEachCompany(ctx context.Context, fn func(Company) error, opts ...CompanyOption) error
+	ListAllCompanies(ctx context.Context, opts ...CompanyOption) ([]Company, error)
+	BatchReadCompanies(ctx context.Context, input *BatchReadCompaniesInput) (*BatchCompaniesResponse, error)
+	BatchCreateCompanies(ctx context.Context, input *BatchCreateCompaniesInput) (*BatchCompaniesResponse, error)
+	BatchUpdateCompanies(ctx context.Context, input *BatchUpdateCompaniesInput) (*BatchCompaniesResponse, error)
+	BatchArchiveCompanies(ctx context.Context, input *BatchArchiveCompaniesInput) (*BatchArchiveResponse, error)
+	SearchCompanies(ctx context.Context, input *SearchCompaniesInput) (*SearchCompaniesResponse, error)
+	SearchCompaniesByQuery(ctx context.Context, query string, properties []string) (*SearchCompaniesResponse, error)
+	CountCompanies(ctx context.Context, input *SearchCompaniesInput) (int, error)
+	GetCompanyDealSummary(ctx context.Context, companyID string) (DealSummary, error)
+}
+
+var _ API = (*Client)(nil)
@@ -5,8 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/deals"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v4/associations"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 // Client represents the Companies API client
@@ -23,10 +29,16 @@ func NewClient(apiClient *client.Client) *Client {
 
 // CreateCompany creates a new company
 func (c *Client) CreateCompany(ctx context.Context, input *CreateCompanyInput) (*Company, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Companies, input.Properties)
+
 	req := client.NewRequest("POST", "/crm/v3/objects/companies")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Companies)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -35,7 +47,7 @@ func (c *Client) CreateCompany(ctx context.Context, input *CreateCompanyInput) (
 
 	var company Company
 	if err := json.Unmarshal(resp.Body, &company); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal company response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal company response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &company, nil
@@ -45,7 +57,7 @@ func (c *Client) CreateCompany(ctx context.Context, input *CreateCompanyInput) (
 func (c *Client) GetCompany(ctx context.Context, companyID string, opts ...CompanyOption) (*Company, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/companies/%s", companyID))
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 
 	for _, opt := range opts {
 		opt(req)
@@ -53,13 +65,14 @@ func (c *Client) GetCompany(ctx context.Context, companyID string, opts ...Compa
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, client.ParseNotFoundError(err, objecttypes.Companies, companyID)
 	}
 
 	var company Company
 	if err := json.Unmarshal(resp.Body, &company); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal company response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal company response: %w", tools.DecodeError(err, resp))
 	}
+	limitHistory(req, &company)
 
 	return &company, nil
 }
@@ -68,7 +81,7 @@ func (c *Client) GetCompany(ctx context.Context, companyID string, opts ...Compa
 func (c *Client) UpdateCompany(ctx context.Context, companyID string, input *UpdateCompanyInput) (*Company, error) {
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/companies/%s", companyID))
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -78,7 +91,7 @@ func (c *Client) UpdateCompany(ctx context.Context, companyID string, input *Upd
 
 	var company Company
 	if err := json.Unmarshal(resp.Body, &company); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal company response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal company response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &company, nil
@@ -88,7 +101,7 @@ func (c *Client) UpdateCompany(ctx context.Context, companyID string, input *Upd
 func (c *Client) ArchiveCompany(ctx context.Context, companyID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/companies/%s", companyID))
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 
 	_, err := c.apiClient.Do(ctx, req)
 	return err
@@ -98,7 +111,8 @@ func (c *Client) ArchiveCompany(ctx context.Context, companyID string) error {
 func (c *Client) ListCompanies(ctx context.Context, opts ...CompanyOption) (*ListCompaniesResponse, error) {
 	req := client.NewRequest("GET", "/crm/v3/objects/companies")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
+	req.WithPaging()
 
 	for _, opt := range opts {
 		opt(req)
@@ -111,18 +125,88 @@ func (c *Client) ListCompanies(ctx context.Context, opts ...CompanyOption) (*Lis
 
 	var listResp ListCompaniesResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal companies list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal companies list response: %w", tools.DecodeError(err, resp))
+	}
+	for i := range listResp.Results {
+		limitHistory(req, &listResp.Results[i])
 	}
 
 	return &listResp, nil
 }
 
+// limitHistory trims each property history of company to the limit set with WithCompanyHistoryLimit, if any
+func limitHistory(req *client.Request, company *Company) {
+	limit, ok := req.GetMetadata(historyLimitKey)
+	if !ok {
+		return
+	}
+	for name, history := range company.PropertiesWithHistory {
+		if len(history) > limit.(int) {
+			// Clone so the dropped entries aren't kept alive by the backing array
+			company.PropertiesWithHistory[name] = slices.Clone(history[:limit.(int)])
+		}
+	}
+}
+
+// ForEachCompany calls fn with every company ListAllCompanies would return, one page at a time, stopping at the first error
+//
+// opts are applied to every page, so WithLimit sets the page size and WithProperties the properties read. A WithAfter
+// option sets the cursor of the first page only. The error from fn is returned unwrapped, and a page repeating the
+// previous cursor returns an error wrapping client.ErrTooManyPages
+func (c *Client) ForEachCompany(ctx context.Context, fn func(Company) error, opts ...CompanyOption) error {
+	var after string
+	for {
+		pageOpts := opts
+		if after != "" {
+			pageOpts = append(slices.Clip(opts), WithAfter(after))
+		}
+
+		page, err := c.ListCompanies(ctx, pageOpts...)
+		if err != nil {
+			return err
+		}
+		for _, company := range page.Results {
+			if err := fn(company); err != nil {
+				return err
+			}
+		}
+
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return nil
+		}
+		if page.Paging.Next.After == after {
+			return fmt.Errorf("companies paging repeated cursor %q: %w", after, client.ErrTooManyPages)
+		}
+		after = page.Paging.Next.After
+	}
+}
+
+// ListAllCompanies lists every company, following the paging cursor of ListCompanies until the last page
+//
+// opts are applied to every page as in ForEachCompany
+func (c *Client) ListAllCompanies(ctx context.Context, opts ...CompanyOption) ([]Company, error) {
+	var results []Company
+	err := c.ForEachCompany(ctx, func(company Company) error {
+		results = append(results, company)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // BatchReadCompanies retrieves multiple companies by ID
+//
+// Set input.Archived to read archived companies; active and archived companies can't be read in the same call
 func (c *Client) BatchReadCompanies(ctx context.Context, input *BatchReadCompaniesInput) (*BatchCompaniesResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/companies/batch/read")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 	req.WithBody(input)
+	if input.Archived {
+		req.AddQueryParam("archived", "true")
+	}
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -131,7 +215,7 @@ func (c *Client) BatchReadCompanies(ctx context.Context, input *BatchReadCompani
 
 	var batchResp BatchCompaniesResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -139,10 +223,19 @@ func (c *Client) BatchReadCompanies(ctx context.Context, input *BatchReadCompani
 
 // BatchCreateCompanies creates multiple companies
 func (c *Client) BatchCreateCompanies(ctx context.Context, input *BatchCreateCompaniesInput) (*BatchCompaniesResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Companies, withDefaults.Inputs[i].Properties)
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/companies/batch/create")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Companies)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -151,7 +244,7 @@ func (c *Client) BatchCreateCompanies(ctx context.Context, input *BatchCreateCom
 
 	var batchResp BatchCompaniesResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -161,7 +254,7 @@ func (c *Client) BatchCreateCompanies(ctx context.Context, input *BatchCreateCom
 func (c *Client) BatchUpdateCompanies(ctx context.Context, input *BatchUpdateCompaniesInput) (*BatchCompaniesResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/companies/batch/update")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -171,28 +264,51 @@ func (c *Client) BatchUpdateCompanies(ctx context.Context, input *BatchUpdateCom
 
 	var batchResp BatchCompaniesResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
 }
 
 // BatchArchiveCompanies archives multiple companies
-func (c *Client) BatchArchiveCompanies(ctx context.Context, input *BatchArchiveCompaniesInput) error {
+//
+// If some records could not be archived, the response lists them in Errors and the returned error joins a *BatchError
+// for each entry, so errors.As finds the first and the response holds them all. The other records are still archived
+func (c *Client) BatchArchiveCompanies(ctx context.Context, input *BatchArchiveCompaniesInput) (*BatchArchiveResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/companies/batch/archive")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
 	req.WithBody(input)
 
-	_, err := c.apiClient.Do(ctx, req)
-	return err
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveResp := BatchArchiveResponse{Status: "COMPLETE"}
+	if len(resp.Body) > 0 {
+		if err := json.Unmarshal(resp.Body, &archiveResp); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal batch archive response: %w", tools.DecodeError(err, resp))
+		}
+	}
+
+	return &archiveResp, batchErrors(archiveResp.Errors)
 }
 
 // SearchCompanies searches for companies
+//
+// Filters are validated before the request is sent; a *FilterValidationError is returned for malformed filters.
+// Query is a free-text search across the default searchable properties; when set together with FilterGroups, results
+// must match both
 func (c *Client) SearchCompanies(ctx context.Context, input *SearchCompaniesInput) (*SearchCompaniesResponse, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/companies/search")
 	req.WithContext(ctx)
-	req.WithResourceType("companies")
+	req.WithResourceType(objecttypes.Companies)
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -202,8 +318,123 @@ func (c *Client) SearchCompanies(ctx context.Context, input *SearchCompaniesInpu
 
 	var searchResp SearchCompaniesResponse
 	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &searchResp, nil
 }
+
+// SearchCompaniesByQuery runs a free-text search for companies matching query and returns the given properties
+func (c *Client) SearchCompaniesByQuery(ctx context.Context, query string, properties []string) (*SearchCompaniesResponse, error) {
+	return c.SearchCompanies(ctx, &SearchCompaniesInput{
+		FilterGroups: []FilterGroup{},
+		Sorts:        []string{},
+		Query:        query,
+		Properties:   properties,
+	})
+}
+
+// CountCompanies returns how many companies match input without downloading them
+//
+// The search is sent with a limit of 1 and a single property, and the one result is discarded
+func (c *Client) CountCompanies(ctx context.Context, input *SearchCompaniesInput) (int, error) {
+	countInput := *input
+	countInput.Limit = 1
+	countInput.After = ""
+	countInput.Properties = []string{"hs_object_id"}
+	if countInput.FilterGroups == nil {
+		countInput.FilterGroups = []FilterGroup{}
+	}
+	if countInput.Sorts == nil {
+		countInput.Sorts = []string{}
+	}
+
+	searchResp, err := c.SearchCompanies(ctx, &countInput)
+	if err != nil {
+		return 0, err
+	}
+
+	return searchResp.Total, nil
+}
+
+// GetCompanyDealSummary summarizes the deals associated with a company by count and amount, overall and per deal stage
+//
+// Amounts are grouped by deal_currency_code, and only added into TotalAmount when the deals share one currency. Deals
+// without an amount are counted but add nothing to the amounts. A company with no deals returns an empty summary
+func (c *Client) GetCompanyDealSummary(ctx context.Context, companyID string) (DealSummary, error) {
+	summary := DealSummary{AmountByCurrency: map[string]float64{}, ByStage: map[string]DealStageSummary{}}
+
+	var dealIDs []string
+	associationsClient := associations.NewClient(c.apiClient)
+	var opts []associations.AssociationOption
+	for {
+		page, err := associationsClient.ListAssociations(ctx, objecttypes.Companies, companyID, objecttypes.Deals, opts...)
+		if err != nil {
+			return summary, err
+		}
+		for _, assoc := range page.Results {
+			dealIDs = append(dealIDs, assoc.ToObjectID)
+		}
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			break
+		}
+		opts = []associations.AssociationOption{associations.WithAfter(page.Paging.Next.After)}
+	}
+
+	dealsClient := deals.NewClient(c.apiClient)
+	for chunk := range slices.Chunk(dealIDs, 100) {
+		input := &deals.BatchReadDealsInput{
+			Properties:            []string{"amount", "deal_currency_code", "dealstage"},
+			PropertiesWithHistory: []string{},
+		}
+		for _, id := range chunk {
+			input.Inputs = append(input.Inputs, struct {
+				ID string `json:"id"`
+			}{ID: id})
+		}
+
+		batch, err := dealsClient.BatchReadDeals(ctx, input)
+		if err != nil {
+			return summary, err
+		}
+
+		for _, deal := range batch.Results {
+			stage := summary.ByStage[deal.Properties["dealstage"]]
+			if stage.AmountByCurrency == nil {
+				stage.AmountByCurrency = map[string]float64{}
+			}
+			stage.Count++
+			summary.Count++
+
+			if raw := deal.Properties["amount"]; raw != "" {
+				amount, err := strconv.ParseFloat(raw, 64)
+				if err != nil {
+					return summary, fmt.Errorf("invalid amount %q on deal %s: %w", raw, deal.ID, err)
+				}
+				currency := deal.Properties["deal_currency_code"]
+				stage.AmountByCurrency[currency] += amount
+				summary.AmountByCurrency[currency] += amount
+			}
+			summary.ByStage[deal.Properties["dealstage"]] = stage
+		}
+	}
+
+	summary.Currency, summary.TotalAmount = singleCurrency(summary.AmountByCurrency)
+	for name, stage := range summary.ByStage {
+		stage.Currency, stage.Amount = singleCurrency(stage.AmountByCurrency)
+		summary.ByStage[name] = stage
+	}
+
+	return summary, nil
+}
+
+// singleCurrency returns the only currency in amounts and its total, or zero values if amounts mixes currencies
+func singleCurrency(amounts map[string]float64) (string, float64) {
+	if len(amounts) != 1 {
+		return "", 0
+	}
+	for currency, amount := range amounts {
+		return currency, amount
+	}
+	return "", 0
+}
@@ -2,11 +2,15 @@ package companies
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -78,6 +82,39 @@ func TestCreateCompany_Success(t *testing.T) {
 	assert.Equal(t, "Acme Corp", company.Properties["name"])
 }
 
+func TestCreateCompany_WithAssociations(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assocs, ok := body["associations"].([]any)
+		require.True(t, ok)
+		require.Len(t, assocs, 1)
+		assoc := assocs[0].(map[string]any)
+		assert.Equal(t, "101", assoc["to"].(map[string]any)["id"])
+		assocType := assoc["types"].([]any)[0].(map[string]any)
+		assert.Equal(t, "HUBSPOT_DEFINED", assocType["associationCategory"])
+		assert.InDelta(t, 280, assocType["associationTypeId"], 0)
+
+		respondJSON(w, http.StatusCreated, `{"id": "123456", "properties": {}}`)
+	})
+	defer server.Close()
+
+	input := &CreateCompanyInput{
+		Properties: map[string]string{},
+		Associations: []Association{
+			{
+				To:    AssociationTarget{ID: "101"},
+				Types: []AssociationSpec{{AssociationCategory: "HUBSPOT_DEFINED", AssociationTypeID: 280}},
+			},
+		},
+	}
+
+	_, err := companiesClient.CreateCompany(context.Background(), input)
+
+	require.NoError(t, err)
+}
+
 func TestCreateCompany_Error(t *testing.T) {
 	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "Invalid input"}`)
@@ -117,6 +154,30 @@ func TestGetCompany_Success(t *testing.T) {
 	assert.Equal(t, "123456", company.ID)
 }
 
+// TestGetCompany_Timestamps tests that timestamps with and without milliseconds are parsed
+func TestGetCompany_Timestamps(t *testing.T) {
+	responseJSON := `{
+		"id": "123456",
+		"properties": {},
+		"createdAt": "2024-01-02T03:04:05.678Z",
+		"updatedAt": "2024-02-03T04:05:06Z",
+		"archived": true,
+		"archivedAt": "2024-03-04T05:06:07.000Z"
+	}`
+
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	company, err := companiesClient.GetCompany(context.Background(), "123456")
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 678000000, time.UTC), company.CreatedAt.UTC())
+	assert.Equal(t, time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC), company.UpdatedAt.UTC())
+	assert.Equal(t, time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC), company.ArchivedAt.UTC())
+}
+
 func TestGetCompany_WithOptions(t *testing.T) {
 	responseJSON := `{
 		"id": "123456",
@@ -149,6 +210,11 @@ func TestGetCompany_NotFound(t *testing.T) {
 
 	_, err := companiesClient.GetCompany(context.Background(), "999999")
 	require.Error(t, err)
+
+	var notFoundErr *client.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "companies", notFoundErr.ObjectType)
+	assert.Equal(t, "999999", notFoundErr.ObjectID)
 }
 
 // TestUpdateCompany tests updating a company
@@ -297,6 +363,36 @@ func TestBatchReadCompanies_Success(t *testing.T) {
 	assert.Len(t, resp.Results, 1)
 }
 
+// TestBatchReadCompanies_Archived tests that Archived is sent as a query parameter and not in the body
+func TestBatchReadCompanies_Archived(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.NotContains(t, body, "archived")
+		assert.NotContains(t, body, "Archived")
+
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "1", "archived": true}]}`)
+	})
+	defer server.Close()
+
+	input := &BatchReadCompaniesInput{
+		Inputs: []struct {
+			ID string `json:"id"`
+		}{
+			{ID: "1"},
+		},
+		Archived: true,
+	}
+
+	resp, err := companiesClient.BatchReadCompanies(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.True(t, resp.Results[0].Archived)
+}
+
 // TestBatchCreateCompanies tests batch create
 func TestBatchCreateCompanies_Success(t *testing.T) {
 	responseJSON := `{
@@ -367,9 +463,41 @@ func TestBatchArchiveCompanies_Success(t *testing.T) {
 	defer server.Close()
 
 	input := &BatchArchiveCompaniesInput{}
-	err := companiesClient.BatchArchiveCompanies(context.Background(), input)
+	resp, err := companiesClient.BatchArchiveCompanies(context.Background(), input)
 
 	require.NoError(t, err)
+	assert.Equal(t, "COMPLETE", resp.Status)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestBatchArchiveCompanies_PartialFailure tests that records which failed to archive are reported
+func TestBatchArchiveCompanies_PartialFailure(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusMultiStatus, `{
+			"status": "COMPLETE",
+			"numErrors": 2,
+			"errors": [
+				{"status": "error", "category": "OBJECT_NOT_FOUND", "message": "Could not find records", "context": {"ids": ["3", "4"]}},
+				{"status": "error", "category": "VALIDATION_ERROR", "message": "Record is locked", "context": {"ids": ["7"]}}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	input := &BatchArchiveCompaniesInput{}
+	resp, err := companiesClient.BatchArchiveCompanies(context.Background(), input)
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 2, resp.NumErrors)
+	require.Len(t, resp.Errors, 2)
+	assert.Equal(t, []string{"3", "4"}, resp.Errors[0].IDs())
+	assert.Equal(t, []string{"7"}, resp.Errors[1].IDs())
+
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, "OBJECT_NOT_FOUND", batchErr.Category)
+	assert.Contains(t, err.Error(), "Record is locked (ids: 7)")
 }
 
 // TestSearchCompanies tests search functionality
@@ -419,6 +547,71 @@ func TestSearchCompanies_Success(t *testing.T) {
 	assert.Len(t, resp.Results, 1)
 }
 
+// TestSearchCompaniesByQuery tests that the free-text query is sent in the request body
+func TestSearchCompaniesByQuery(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/companies/search", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Acme", body["query"])
+		assert.Equal(t, []any{"name"}, body["properties"])
+		assert.Equal(t, []any{}, body["filterGroups"])
+
+		respondJSON(w, http.StatusOK, `{"total": 1, "results": [{"id": "1", "properties": {"name": "Acme"}, "archived": false}]}`)
+	})
+	defer server.Close()
+
+	resp, err := companiesClient.SearchCompaniesByQuery(context.Background(), "Acme", []string{"name"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, "1", resp.Results[0].ID)
+}
+
+// TestCountCompanies tests that counting sends a minimal search and returns the total
+func TestCountCompanies(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body SearchCompaniesInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 1, body.Limit)
+		assert.Equal(t, []string{"hs_object_id"}, body.Properties)
+		assert.Equal(t, "EQ", string(body.FilterGroups[0].Filters[0].Operator))
+
+		respondJSON(w, http.StatusOK, `{"total": 1234, "results": [{"id": "1", "properties": {}, "archived": false}]}`)
+	})
+	defer server.Close()
+
+	count, err := companiesClient.CountCompanies(context.Background(), &SearchCompaniesInput{
+		FilterGroups: []FilterGroup{{Filters: []Filter{{PropertyName: "industry", Operator: "EQ", Value: "SOFTWARE"}}}},
+		Properties:   []string{"name", "domain"},
+		Limit:        100,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1234, count)
+}
+
+// TestSearchCompanies_InvalidFilter tests that malformed filters are rejected before sending
+func TestSearchCompanies_InvalidFilter(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	input := &SearchCompaniesInput{
+		FilterGroups: []FilterGroup{
+			{Filters: []Filter{{PropertyName: "amount", Operator: Between, Value: "100"}}},
+		},
+	}
+
+	_, err := companiesClient.SearchCompanies(context.Background(), input)
+
+	var validationErr *FilterValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "amount", validationErr.PropertyName)
+}
+
 // TestOptions tests all option functions
 func TestOptions(t *testing.T) {
 	t.Run("WithPropertiesWithHistory", func(t *testing.T) {
@@ -439,6 +632,49 @@ func TestOptions(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("WithPropertiesWithHistory repeated", func(t *testing.T) {
+		server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "name,num_associated_contacts", r.URL.Query().Get("propertiesWithHistory"))
+			respondJSON(w, http.StatusOK, `{"id": "123", "properties": {"name": "Test"}}`)
+		})
+		defer server.Close()
+
+		_, err := companiesClient.GetCompany(context.Background(), "123",
+			WithPropertiesWithHistory([]string{"name"}),
+			WithPropertiesWithHistory([]string{"num_associated_contacts", "name"}))
+		require.NoError(t, err)
+	})
+
+	t.Run("WithCompanyHistoryLimit", func(t *testing.T) {
+		server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(w, http.StatusOK, `{
+				"id": "123",
+				"properties": {"num_associated_contacts": "3"},
+				"propertiesWithHistory": {
+					"num_associated_contacts": [
+						{"value": "3", "timestamp": "2024-03-01T00:00:00.000Z"},
+						{"value": "2", "timestamp": "2024-02-01T00:00:00.000Z"},
+						{"value": "1", "timestamp": "2024-01-01T00:00:00.000Z"}
+					],
+					"name": [{"value": "Test", "timestamp": "2024-01-01T00:00:00.000Z"}]
+				}
+			}`)
+		})
+		defer server.Close()
+
+		company, err := companiesClient.GetCompany(context.Background(), "123",
+			WithPropertiesWithHistory([]string{"num_associated_contacts", "name"}),
+			WithCompanyHistoryLimit(2))
+		require.NoError(t, err)
+
+		history := company.PropertiesWithHistory["num_associated_contacts"]
+		require.Len(t, history, 2)
+		assert.Equal(t, 2, cap(history), "dropped entries are released")
+		assert.Equal(t, "3", history[0].Value)
+		assert.Equal(t, "2", history[1].Value)
+		assert.Len(t, company.PropertiesWithHistory["name"], 1)
+	})
+
 	t.Run("WithAssociations", func(t *testing.T) {
 		server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
 			assert.Equal(t, "contacts,deals", r.URL.Query().Get("associations"))
@@ -550,7 +786,7 @@ func TestErrorHandling(t *testing.T) {
 		defer server.Close()
 
 		input := &BatchArchiveCompaniesInput{}
-		err := companiesClient.BatchArchiveCompanies(context.Background(), input)
+		_, err := companiesClient.BatchArchiveCompanies(context.Background(), input)
 		require.Error(t, err)
 	})
 
@@ -616,3 +852,159 @@ func TestInvalidJSON(t *testing.T) {
 		assert.Contains(t, err.Error(), "unmarshal")
 	})
 }
+
+// TestGetCompanyDealSummary_Success tests summarizing two associated deals
+func TestGetCompanyDealSummary_Success(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/objects/companies/123/associations/deals":
+			respondJSON(w, http.StatusOK, `{
+				"results": [
+					{"toObjectId": "1", "associationTypes": [{"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 342}]},
+					{"toObjectId": "2", "associationTypes": [{"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 342}]}
+				]
+			}`)
+		case "/crm/v3/objects/deals/batch/read":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Len(t, body["inputs"], 2)
+
+			respondJSON(w, http.StatusOK, `{
+				"status": "COMPLETE",
+				"results": [
+					{"id": "1", "properties": {"amount": "1500.50", "deal_currency_code": "USD", "dealstage": "closedwon"}},
+					{"id": "2", "properties": {"amount": "500", "deal_currency_code": "USD", "dealstage": "appointmentscheduled"}}
+				]
+			}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	summary, err := companiesClient.GetCompanyDealSummary(context.Background(), "123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.Count)
+	assert.InDelta(t, 2000.50, summary.TotalAmount, 0.001)
+	assert.Equal(t, "USD", summary.Currency)
+	assert.Equal(t, DealStageSummary{Count: 1, Amount: 1500.50, Currency: "USD", AmountByCurrency: map[string]float64{"USD": 1500.50}}, summary.ByStage["closedwon"])
+	assert.Equal(t, DealStageSummary{Count: 1, Amount: 500, Currency: "USD", AmountByCurrency: map[string]float64{"USD": 500}}, summary.ByStage["appointmentscheduled"])
+}
+
+// TestGetCompanyDealSummary_MixedCurrencies tests that amounts in different currencies are totalled separately
+func TestGetCompanyDealSummary_MixedCurrencies(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/objects/companies/123/associations/deals":
+			respondJSON(w, http.StatusOK, `{
+				"results": [
+					{"toObjectId": "1", "associationTypes": [{"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 342}]},
+					{"toObjectId": "2", "associationTypes": [{"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 342}]},
+					{"toObjectId": "3", "associationTypes": [{"associationCategory": "HUBSPOT_DEFINED", "associationTypeId": 342}]}
+				]
+			}`)
+		case "/crm/v3/objects/deals/batch/read":
+			respondJSON(w, http.StatusOK, `{
+				"status": "COMPLETE",
+				"results": [
+					{"id": "1", "properties": {"amount": "100", "deal_currency_code": "USD", "dealstage": "closedwon"}},
+					{"id": "2", "properties": {"amount": "200", "deal_currency_code": "EUR", "dealstage": "closedwon"}},
+					{"id": "3", "properties": {"amount": "50", "deal_currency_code": "USD", "dealstage": "qualifiedtobuy"}}
+				]
+			}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	summary, err := companiesClient.GetCompanyDealSummary(context.Background(), "123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Count)
+	assert.Zero(t, summary.TotalAmount)
+	assert.Empty(t, summary.Currency)
+	assert.Equal(t, map[string]float64{"USD": 150, "EUR": 200}, summary.AmountByCurrency)
+	assert.Zero(t, summary.ByStage["closedwon"].Amount)
+	assert.Equal(t, map[string]float64{"USD": 100, "EUR": 200}, summary.ByStage["closedwon"].AmountByCurrency)
+	assert.Equal(t, 50.0, summary.ByStage["qualifiedtobuy"].Amount)
+	assert.Equal(t, "USD", summary.ByStage["qualifiedtobuy"].Currency)
+}
+
+// TestGetCompanyDealSummary_NoDeals tests a company without associated deals
+func TestGetCompanyDealSummary_NoDeals(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v4/objects/companies/123/associations/deals", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"results": []}`)
+	})
+	defer server.Close()
+
+	summary, err := companiesClient.GetCompanyDealSummary(context.Background(), "123")
+
+	require.NoError(t, err)
+	assert.Zero(t, summary.Count)
+	assert.Zero(t, summary.TotalAmount)
+	assert.Empty(t, summary.ByStage)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
+
+// TestListAllCompanies_FollowsCursor tests that every page is read with the caller's options until paging ends
+func TestListAllCompanies_FollowsCursor(t *testing.T) {
+	var afters []string
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		assert.Equal(t, "name", r.URL.Query().Get("properties"))
+		afters = append(afters, r.URL.Query().Get("after"))
+		switch r.URL.Query().Get("after") {
+		case "":
+			respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`)
+		case "2":
+			respondJSON(w, http.StatusOK, `{"results": [{"id": "3"}]}`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("after"))
+		}
+	})
+	defer server.Close()
+
+	results, err := companiesClient.ListAllCompanies(context.Background(), WithLimit(2), WithProperties([]string{"name"}))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "3", results[2].ID)
+	assert.Equal(t, []string{"", "2"}, afters)
+}
+
+// TestForEachCompany_StopsOnError tests that an error from the callback stops paging and is returned
+func TestForEachCompany_StopsOnError(t *testing.T) {
+	requests := 0
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`)
+	})
+	defer server.Close()
+
+	stop := errors.New("stop")
+	seen := 0
+	err := companiesClient.ForEachCompany(context.Background(), func(company Company) error {
+		seen++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, seen)
+	assert.Equal(t, 1, requests)
+}
+
+// TestListAllCompanies_RepeatedCursor tests that a page repeating the previous cursor ends paging with an error
+func TestListAllCompanies_RepeatedCursor(t *testing.T) {
+	server, companiesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}], "paging": {"next": {"after": "a"}}}`)
+	})
+	defer server.Close()
+
+	_, err := companiesClient.ListAllCompanies(context.Background())
+	assert.ErrorIs(t, err, client.ErrTooManyPages)
+}
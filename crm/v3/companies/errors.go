@@ -0,0 +1,48 @@
+package companies
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FilterValidationError is returned when a search filter is missing the values its operator requires
+type FilterValidationError struct {
+	PropertyName string
+	Operator     FilterOperator
+	Message      string
+}
+
+func (e *FilterValidationError) Error() string {
+	return fmt.Sprintf("invalid filter on property %s: operator %s %s", e.PropertyName, e.Operator, e.Message)
+}
+
+// BatchError describes records that failed in an otherwise accepted batch request
+type BatchError struct {
+	Status      string              `json:"status"`
+	Category    string              `json:"category"`
+	SubCategory any                 `json:"subCategory"`
+	Message     string              `json:"message"`
+	Context     map[string][]string `json:"context"`
+}
+
+// IDs returns the IDs of the records that failed, as listed in the error context
+func (e *BatchError) IDs() []string {
+	return e.Context["ids"]
+}
+
+func (e *BatchError) Error() string {
+	if ids := e.IDs(); len(ids) > 0 {
+		return fmt.Sprintf("%s: %s (ids: %s)", e.Category, e.Message, strings.Join(ids, ", "))
+	}
+	return fmt.Sprintf("%s: %s", e.Category, e.Message)
+}
+
+// batchErrors returns errs as a single error whose parts are each a *BatchError, or nil if there are none
+func batchErrors(errs []BatchError) error {
+	joined := make([]error, len(errs))
+	for i := range errs {
+		joined[i] = &errs[i]
+	}
+	return errors.Join(joined...)
+}
@@ -0,0 +1,108 @@
+package companies
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFilterGroupsFromObjects tests converting objects filter groups
+func TestFilterGroupsFromObjects(t *testing.T) {
+	groups := []objects.SearchFilterGroup{
+		{Filters: []objects.SearchFilter{
+			{PropertyName: "amount", Operator: objects.Between, Value: "100", HighValue: "200"},
+			{PropertyName: "hs_object_id", Operator: objects.In, Values: []string{"1", "2"}},
+		}},
+		{Filters: []objects.SearchFilter{
+			{PropertyName: "name", Operator: objects.HasProperty},
+		}},
+	}
+
+	converted := FilterGroupsFromObjects(groups)
+
+	assert.Equal(t, []FilterGroup{
+		{Filters: []Filter{
+			{PropertyName: "amount", Operator: Between, Value: "100", HighValue: "200"},
+			{PropertyName: "hs_object_id", Operator: In, Values: []any{"1", "2"}},
+		}},
+		{Filters: []Filter{
+			{PropertyName: "name", Operator: HasProperty},
+		}},
+	}, converted)
+	assert.Nil(t, FilterGroupsFromObjects(nil))
+}
+
+// TestObjectsFilterGroups tests converting filter groups for the objects client
+func TestObjectsFilterGroups(t *testing.T) {
+	groups := []FilterGroup{
+		{Filters: []Filter{
+			{PropertyName: "amount", Operator: Between, Value: 1000000.0, HighValue: 2.5},
+			{PropertyName: "hs_object_id", Operator: NotIn, Values: []any{1, "2"}},
+			{PropertyName: "name", Operator: NotHasProperty},
+		}},
+	}
+
+	converted := ObjectsFilterGroups(groups)
+
+	assert.Equal(t, []objects.SearchFilterGroup{
+		{Filters: []objects.SearchFilter{
+			{PropertyName: "amount", Operator: objects.Between, Value: "1000000", HighValue: "2.5"},
+			{PropertyName: "hs_object_id", Operator: objects.NotIn, Values: []string{"1", "2"}},
+			{PropertyName: "name", Operator: objects.NotHasProperty},
+		}},
+	}, converted)
+}
+
+// TestFilter_MarshalJSON tests that filter values are sent as strings whatever their Go type
+func TestFilter_MarshalJSON(t *testing.T) {
+	closeDate := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected string
+	}{
+		{
+			name:     "Numeric",
+			filter:   Filter{PropertyName: "amount", Operator: Between, Value: 1000, HighValue: 2500.5},
+			expected: `{"propertyName":"amount","operator":"BETWEEN","value":"1000","highValue":"2500.5"}`,
+		},
+		{
+			name:     "Large float",
+			filter:   Filter{PropertyName: "amount", Operator: GTE, Value: 1e7},
+			expected: `{"propertyName":"amount","operator":"GTE","value":"10000000"}`,
+		},
+		{
+			name:     "Boolean",
+			filter:   Filter{PropertyName: "hs_is_closed", Operator: EQ, Value: true},
+			expected: `{"propertyName":"hs_is_closed","operator":"EQ","value":"true"}`,
+		},
+		{
+			name:     "Timestamp",
+			filter:   Filter{PropertyName: "closedate", Operator: LT, Value: closeDate},
+			expected: `{"propertyName":"closedate","operator":"LT","value":"1709294400000"}`,
+		},
+		{
+			name:     "Values",
+			filter:   Filter{PropertyName: "hs_object_id", Operator: In, Values: []any{1, "2", int64(3)}},
+			expected: `{"propertyName":"hs_object_id","operator":"IN","values":["1","2","3"]}`,
+		},
+		{
+			name:     "No value",
+			filter:   Filter{PropertyName: "name", Operator: HasProperty},
+			expected: `{"propertyName":"name","operator":"HAS_PROPERTY"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.filter)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(data))
+		})
+	}
+}
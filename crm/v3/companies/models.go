@@ -1,14 +1,34 @@
 package companies
 
+import "time"
+
+type FilterOperator string
+
+const (
+	EQ               FilterOperator = "EQ"
+	NEQ              FilterOperator = "NEQ"
+	LT               FilterOperator = "LT"
+	LTE              FilterOperator = "LTE"
+	GT               FilterOperator = "GT"
+	GTE              FilterOperator = "GTE"
+	Between          FilterOperator = "BETWEEN"
+	In               FilterOperator = "IN"
+	NotIn            FilterOperator = "NOT_IN"
+	HasProperty      FilterOperator = "HAS_PROPERTY"
+	NotHasProperty   FilterOperator = "NOT_HAS_PROPERTY"
+	ContainsToken    FilterOperator = "CONTAINS_TOKEN"
+	NotContainsToken FilterOperator = "NOT_CONTAINS_TOKEN"
+)
+
 // Company represents a HubSpot company object
 type Company struct {
 	ID                    string                           `json:"id"`
 	Properties            map[string]string                `json:"properties"`
 	PropertiesWithHistory map[string][]PropertyWithHistory `json:"propertiesWithHistory"`
-	CreatedAt             string                           `json:"createdAt"`
-	UpdatedAt             string                           `json:"updatedAt"`
+	CreatedAt             time.Time                        `json:"createdAt"`
+	UpdatedAt             time.Time                        `json:"updatedAt"`
 	Archived              bool                             `json:"archived"`
-	ArchivedAt            string                           `json:"archivedAt"`
+	ArchivedAt            time.Time                        `json:"archivedAt"`
 }
 
 // PropertyWithHistory represents a property with its historical values
@@ -23,7 +43,25 @@ type PropertyWithHistory struct {
 
 // CreateCompanyInput represents the input for creating a company
 type CreateCompanyInput struct {
-	Properties map[string]string `json:"properties"`
+	Properties   map[string]string `json:"properties"`
+	Associations []Association     `json:"associations,omitempty"`
+}
+
+// Association associates a new company to an existing record in the create request
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// AssociationTarget identifies the record to associate to
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationSpec defines the type of an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
 }
 
 // UpdateCompanyInput represents the input for updating a company
@@ -57,6 +95,9 @@ type BatchReadCompaniesInput struct {
 	Inputs                []struct {
 		ID string `json:"id"`
 	} `json:"inputs"`
+
+	// Archived reads archived companies instead of active ones. It is sent as the archived query parameter
+	Archived bool `json:"-"`
 }
 
 // BatchCreateCompaniesInput represents input for batch create
@@ -79,6 +120,18 @@ type BatchArchiveCompaniesInput struct {
 	} `json:"inputs"`
 }
 
+// BatchArchiveResponse reports the outcome of a batch archive
+//
+// HubSpot answers an archive in which every record succeeded with no body; the response then has Status COMPLETE and
+// no Errors
+type BatchArchiveResponse struct {
+	Status      string       `json:"status"`
+	NumErrors   int          `json:"numErrors"`
+	Errors      []BatchError `json:"errors"`
+	StartedAt   string       `json:"startedAt"`
+	CompletedAt string       `json:"completedAt"`
+}
+
 // BatchCompaniesResponse represents response from batch operations
 type BatchCompaniesResponse struct {
 	Status      string    `json:"status"`
@@ -97,16 +150,64 @@ type SearchCompaniesInput struct {
 	After        string        `json:"after"`
 }
 
+// Validate checks every filter in the search input
+func (in *SearchCompaniesInput) Validate() error {
+	for _, group := range in.FilterGroups {
+		for _, filter := range group.Filters {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // FilterGroup represents a group of filters
 type FilterGroup struct {
 	Filters []Filter `json:"filters"`
 }
 
 // Filter represents a single filter
+//
+// BETWEEN filters require HighValue, IN and NOT_IN filters require Values. Values may be strings, numbers, booleans
+// or time.Time; they are sent to HubSpot as strings, see MarshalJSON
 type Filter struct {
-	PropertyName string      `json:"propertyName"`
-	Operator     string      `json:"operator"`
-	Value        interface{} `json:"value"`
+	PropertyName string         `json:"propertyName"`
+	Operator     FilterOperator `json:"operator"`
+	Value        any            `json:"value,omitempty"`
+	HighValue    any            `json:"highValue,omitempty"`
+	Values       []any          `json:"values,omitempty"`
+}
+
+// Validate checks that the filter has the values its operator requires
+func (f Filter) Validate() error {
+	switch f.Operator {
+	case EQ, NEQ, LT, LTE, GT, GTE, ContainsToken, NotContainsToken:
+		if isEmptyFilterValue(f.Value) {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Value"}
+		}
+	case Between:
+		if isEmptyFilterValue(f.Value) || isEmptyFilterValue(f.HighValue) {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Value and HighValue"}
+		}
+	case In, NotIn:
+		if len(f.Values) == 0 {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Values"}
+		}
+	case HasProperty, NotHasProperty:
+	default:
+		return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "unknown operator"}
+	}
+	return nil
+}
+
+// isEmptyFilterValue reports whether a filter value is unset
+func isEmptyFilterValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	s, ok := v.(string)
+	return ok && s == ""
 }
 
 // SearchCompaniesResponse represents response from search
@@ -115,3 +216,23 @@ type SearchCompaniesResponse struct {
 	Results []Company `json:"results"`
 	Paging  *Paging   `json:"paging"`
 }
+
+// DealSummary summarizes the deals associated with a company
+//
+// Amounts are totalled per deal_currency_code in AmountByCurrency. TotalAmount and Currency are only set when every
+// deal with an amount has the same currency code, and are zero when the deals mix currencies
+type DealSummary struct {
+	Count            int
+	TotalAmount      float64
+	Currency         string
+	AmountByCurrency map[string]float64          // Keyed by the deal_currency_code property, empty when HubSpot didn't set it
+	ByStage          map[string]DealStageSummary // Keyed by the dealstage property
+}
+
+// DealStageSummary summarizes the associated deals in a single deal stage, with amounts totalled as in DealSummary
+type DealStageSummary struct {
+	Count            int
+	Amount           float64
+	Currency         string
+	AmountByCurrency map[string]float64
+}
@@ -10,24 +10,41 @@ import (
 // CompanyOption represents a functional option for company requests
 type CompanyOption func(*client.Request)
 
-// WithProperties specifies which properties to return
+// WithProperties specifies which properties to return; repeated calls add to the list
 func WithProperties(properties []string) CompanyOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("properties", strings.Join(properties, ","))
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
 	}
 }
 
-// WithPropertiesWithHistory specifies which properties to return with history
+// WithPropertiesWithHistory specifies which properties to return with history; repeated calls add to the list
 func WithPropertiesWithHistory(properties []string) CompanyOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("propertiesWithHistory", strings.Join(properties, ","))
+		req.AppendQueryParam("propertiesWithHistory", strings.Join(properties, ","))
 	}
 }
 
-// WithAssociations specifies which associations to return
+// historyLimitKey is the request metadata key set by WithCompanyHistoryLimit
+const historyLimitKey = "companies.historyLimit"
+
+// WithCompanyHistoryLimit keeps only the n most recent history entries of each property requested with
+// WithPropertiesWithHistory
+//
+// HubSpot has no parameter to limit property history, so the full history is still downloaded; the older entries are
+// dropped once the response is decoded so they aren't held in memory. HubSpot returns history newest first. Values
+// below 1 are ignored
+func WithCompanyHistoryLimit(n int) CompanyOption {
+	return func(req *client.Request) {
+		if n > 0 {
+			req.SetMetadata(historyLimitKey, n)
+		}
+	}
+}
+
+// WithAssociations specifies which associations to return; repeated calls add to the list
 func WithAssociations(associations []string) CompanyOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("associations", strings.Join(associations, ","))
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
 	}
 }
 
@@ -0,0 +1,18 @@
+package contacts
+
+import "context"
+
+// API is the set of contacts client methods, implemented by *Client
+//
+// Code that calls the contacts API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	GetContact(ctx context.Context, contactID string, opts ...GetContactOption) (*Contact, error)
+	CreateContact(ctx context.Context, input *CreateContactInput) (*Contact, error)
+	UpdateContact(ctx context.Context, contactID string, input *UpdateContactInput) (*Contact, error)
+	DeleteContact(ctx context.Context, contactID string) error
+	ListContacts(ctx context.Context, opts ...ListContactsOption) ([]Contact, string, error)
+	SearchContacts(ctx context.Context, input *SearchContactsInput) (*SearchContactsResponse, error)
+}
+
+var _ API = (*Client)(nil)
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 type Client struct {
@@ -23,7 +25,7 @@ func NewClient(apiClient *client.Client) *Client {
 func (c *Client) GetContact(ctx context.Context, contactID string, opts ...GetContactOption) (*Contact, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/contacts/%s", contactID))
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
+	req.WithResourceType(objecttypes.Contacts)
 
 	// Apply options
 	for _, opt := range opts {
@@ -37,7 +39,7 @@ func (c *Client) GetContact(ctx context.Context, contactID string, opts ...GetCo
 
 	var contact ContactResponse
 	if err := json.Unmarshal(resp.Body, &contact); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal contact response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal contact response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &Contact{
@@ -48,10 +50,16 @@ func (c *Client) GetContact(ctx context.Context, contactID string, opts ...GetCo
 }
 
 func (c *Client) CreateContact(ctx context.Context, input *CreateContactInput) (*Contact, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Contacts, input.Properties)
+
 	req := client.NewRequest("POST", "/crm/v3/objects/contacts")
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Contacts)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -60,7 +68,7 @@ func (c *Client) CreateContact(ctx context.Context, input *CreateContactInput) (
 
 	var contact ContactResponse
 	if err := json.Unmarshal(resp.Body, &contact); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal contact response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal contact response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &Contact{
@@ -73,7 +81,7 @@ func (c *Client) CreateContact(ctx context.Context, input *CreateContactInput) (
 func (c *Client) UpdateContact(ctx context.Context, contactID string, input *UpdateContactInput) (*Contact, error) {
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/contacts/%s", contactID))
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
+	req.WithResourceType(objecttypes.Contacts)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -83,7 +91,7 @@ func (c *Client) UpdateContact(ctx context.Context, contactID string, input *Upd
 
 	var contact ContactResponse
 	if err := json.Unmarshal(resp.Body, &contact); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal contact response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal contact response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &Contact{
@@ -96,7 +104,7 @@ func (c *Client) UpdateContact(ctx context.Context, contactID string, input *Upd
 func (c *Client) DeleteContact(ctx context.Context, contactID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/contacts/%s", contactID))
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
+	req.WithResourceType(objecttypes.Contacts)
 
 	_, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -109,7 +117,8 @@ func (c *Client) DeleteContact(ctx context.Context, contactID string) error {
 func (c *Client) ListContacts(ctx context.Context, opts ...ListContactsOption) ([]Contact, string, error) {
 	req := client.NewRequest("GET", "/crm/v3/objects/contacts")
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
+	req.WithResourceType(objecttypes.Contacts)
+	req.WithPaging()
 
 	// Apply options
 	for _, opt := range opts {
@@ -132,7 +141,7 @@ func (c *Client) ListContacts(ctx context.Context, opts ...ListContactsOption) (
 	}
 
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, "", fmt.Errorf("failed to unmarshal contacts list response: %w", err)
+		return nil, "", fmt.Errorf("failed to unmarshal contacts list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return listResp.Results, listResp.Paging.Next.After, nil
@@ -141,7 +150,8 @@ func (c *Client) ListContacts(ctx context.Context, opts ...ListContactsOption) (
 func (c *Client) SearchContacts(ctx context.Context, input *SearchContactsInput) (*SearchContactsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/contacts/search")
 	req.WithContext(ctx)
-	req.WithResourceType("contacts")
+	req.WithResourceType(objecttypes.Contacts)
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -151,7 +161,7 @@ func (c *Client) SearchContacts(ctx context.Context, input *SearchContactsInput)
 
 	var searchResp SearchContactsResponse
 	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &searchResp, nil
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -175,6 +176,11 @@ func TestGetContact_NotFound(t *testing.T) {
 	var notFoundErr *ContactNotFoundError
 	require.ErrorAs(t, err, &notFoundErr)
 	assert.Equal(t, "99999", notFoundErr.ContactID)
+
+	var sharedErr *client.NotFoundError
+	require.ErrorAs(t, err, &sharedErr)
+	assert.Equal(t, "contacts", sharedErr.ObjectType)
+	assert.Equal(t, "99999", sharedErr.ObjectID)
 }
 
 // TestGetContact_InvalidJSON tests JSON unmarshal error
@@ -568,3 +574,8 @@ func TestListContacts_InvalidJSON(t *testing.T) {
 	assert.Empty(t, nextCursor)
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
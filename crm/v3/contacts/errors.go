@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
 )
 
 // ContactNotFoundError is returned when a contact is not found
@@ -16,6 +17,11 @@ func (e *ContactNotFoundError) Error() string {
 	return fmt.Sprintf("contact %s not found", e.ContactID)
 }
 
+// Unwrap returns the error as a *client.NotFoundError, which in turn unwraps to the HubSpot error it was built from
+func (e *ContactNotFoundError) Unwrap() error {
+	return &client.NotFoundError{ObjectType: objecttypes.Contacts, ObjectID: e.ContactID, Original: e.Original}
+}
+
 // ContactValidationError is returned on validation failures
 type ContactValidationError struct {
 	Field    string
@@ -27,6 +33,14 @@ func (e *ContactValidationError) Error() string {
 	return fmt.Sprintf("validation error on field %s: %s", e.Field, e.Message)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ContactValidationError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
 // ContactAlreadyExistsError is returned when trying to create a duplicate
 type ContactAlreadyExistsError struct {
 	ContactID string
@@ -37,6 +51,14 @@ func (e *ContactAlreadyExistsError) Error() string {
 	return fmt.Sprintf("contact with email %s already exists", e.ContactID)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ContactAlreadyExistsError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
 // ParseContactError converts a generic HubSpot error to a contact-specific error
 func ParseContactError(err error, contactID string) error {
 	if hubspotErr, ok := err.(*client.HubSpotError); ok {
@@ -47,7 +69,7 @@ func ParseContactError(err error, contactID string) error {
 				Original:  hubspotErr,
 			}
 		case 400:
-			if hubspotErr.Category == "VALIDATION_ERROR" {
+			if hubspotErr.Category == client.CategoryValidation {
 				return &ContactValidationError{
 					Field:    hubspotErr.Message,
 					Original: hubspotErr,
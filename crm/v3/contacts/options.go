@@ -10,17 +10,17 @@ import (
 // GetContactOption is a functional option for GetContact
 type GetContactOption func(*client.Request)
 
-// WithProperties specifies which properties to retrieve
+// WithProperties specifies which properties to retrieve; repeated calls add to the list
 func WithProperties(properties []string) GetContactOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("properties", strings.Join(properties, ","))
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
 	}
 }
 
-// WithAssociations specifies which associations to retrieve
+// WithAssociations specifies which associations to retrieve; repeated calls add to the list
 func WithAssociations(associations []string) GetContactOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("associations", strings.Join(associations, ","))
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
 	}
 }
 
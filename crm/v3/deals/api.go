@@ -0,0 +1,28 @@
+package deals
+
+import "context"
+
+// API is the set of deals client methods, implemented by *Client
+//
+// Code that calls the deals API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateDeal(ctx context.Context, input *CreateDealInput) (*Deal, error)
+	GetDeal(ctx context.Context, dealID string, opts ...DealOption) (*Deal, error)
+	UpdateDeal(ctx context.Context, dealID string, input *UpdateDealInput) (*Deal, error)
+	ArchiveDeal(ctx context.Context, dealID string) error
+	ListDeals(ctx context.Context, opts ...DealOption) (*ListDealsResponse, error)
+	ForEachDeal(ctx context.Context, fn func(Deal) error, opts ...DealOption) error
+	ListAllDeals(ctx context.Context, opts ...DealOption) ([]Deal, error)
+	BatchReadDeals(ctx context.Context, input *BatchReadDealsInput) (*BatchDealsResponse, error)
+	BatchCreateDeals(ctx context.Context, input *BatchCreateDealsInput) (*BatchDealsResponse, error)
+	BatchUpdateDeals(ctx context.Context, input *BatchUpdateDealsInput) (*BatchDealsResponse, error)
+	BatchArchiveDeals(ctx context.Context, input *BatchArchiveDealsInput) (*BatchArchiveResponse, error)
+	SearchDeals(ctx context.Context, input *SearchDealsInput) (*SearchDealsResponse, error)
+	SearchDealsByQuery(ctx context.Context, query string, properties []string) (*SearchDealsResponse, error)
+	CountDeals(ctx context.Context, input *SearchDealsInput) (int, error)
+	GetDealStageHistory(ctx context.Context, dealID string) ([]StageTransition, error)
+	CreateDealWithStage(ctx context.Context, name, pipeline, stage string, amount float64, opts ...CreateOption) (*Deal, error)
+}
+
+var _ API = (*Client)(nil)
@@ -5,8 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 // Client represents the Deals API client
@@ -23,10 +29,16 @@ func NewClient(apiClient *client.Client) *Client {
 
 // CreateDeal creates a new deal
 func (c *Client) CreateDeal(ctx context.Context, input *CreateDealInput) (*Deal, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Deals, input.Properties)
+
 	req := client.NewRequest("POST", "/crm/v3/objects/deals")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Deals)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -35,7 +47,7 @@ func (c *Client) CreateDeal(ctx context.Context, input *CreateDealInput) (*Deal,
 
 	var deal Deal
 	if err := json.Unmarshal(resp.Body, &deal); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal deal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal deal response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &deal, nil
@@ -45,7 +57,7 @@ func (c *Client) CreateDeal(ctx context.Context, input *CreateDealInput) (*Deal,
 func (c *Client) GetDeal(ctx context.Context, dealID string, opts ...DealOption) (*Deal, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/deals/%s", dealID))
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 
 	for _, opt := range opts {
 		opt(req)
@@ -53,12 +65,12 @@ func (c *Client) GetDeal(ctx context.Context, dealID string, opts ...DealOption)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, client.ParseNotFoundError(err, objecttypes.Deals, dealID)
 	}
 
 	var deal Deal
 	if err := json.Unmarshal(resp.Body, &deal); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal deal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal deal response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &deal, nil
@@ -68,7 +80,7 @@ func (c *Client) GetDeal(ctx context.Context, dealID string, opts ...DealOption)
 func (c *Client) UpdateDeal(ctx context.Context, dealID string, input *UpdateDealInput) (*Deal, error) {
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/deals/%s", dealID))
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -78,7 +90,7 @@ func (c *Client) UpdateDeal(ctx context.Context, dealID string, input *UpdateDea
 
 	var deal Deal
 	if err := json.Unmarshal(resp.Body, &deal); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal deal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal deal response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &deal, nil
@@ -88,7 +100,7 @@ func (c *Client) UpdateDeal(ctx context.Context, dealID string, input *UpdateDea
 func (c *Client) ArchiveDeal(ctx context.Context, dealID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/deals/%s", dealID))
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 
 	_, err := c.apiClient.Do(ctx, req)
 	return err
@@ -98,7 +110,8 @@ func (c *Client) ArchiveDeal(ctx context.Context, dealID string) error {
 func (c *Client) ListDeals(ctx context.Context, opts ...DealOption) (*ListDealsResponse, error) {
 	req := client.NewRequest("GET", "/crm/v3/objects/deals")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
+	req.WithPaging()
 
 	for _, opt := range opts {
 		opt(req)
@@ -111,18 +124,71 @@ func (c *Client) ListDeals(ctx context.Context, opts ...DealOption) (*ListDealsR
 
 	var listResp ListDealsResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal deals list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal deals list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp, nil
 }
 
+// ForEachDeal calls fn with every deal ListAllDeals would return, one page at a time, stopping at the first error
+//
+// opts are applied to every page, so WithLimit sets the page size and WithProperties the properties read. A WithAfter
+// option sets the cursor of the first page only. The error from fn is returned unwrapped, and a page repeating the
+// previous cursor returns an error wrapping client.ErrTooManyPages
+func (c *Client) ForEachDeal(ctx context.Context, fn func(Deal) error, opts ...DealOption) error {
+	var after string
+	for {
+		pageOpts := opts
+		if after != "" {
+			pageOpts = append(slices.Clip(opts), WithAfter(after))
+		}
+
+		page, err := c.ListDeals(ctx, pageOpts...)
+		if err != nil {
+			return err
+		}
+		for _, deal := range page.Results {
+			if err := fn(deal); err != nil {
+				return err
+			}
+		}
+
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return nil
+		}
+		if page.Paging.Next.After == after {
+			return fmt.Errorf("deals paging repeated cursor %q: %w", after, client.ErrTooManyPages)
+		}
+		after = page.Paging.Next.After
+	}
+}
+
+// ListAllDeals lists every deal, following the paging cursor of ListDeals until the last page
+//
+// opts are applied to every page as in ForEachDeal
+func (c *Client) ListAllDeals(ctx context.Context, opts ...DealOption) ([]Deal, error) {
+	var results []Deal
+	err := c.ForEachDeal(ctx, func(deal Deal) error {
+		results = append(results, deal)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // BatchReadDeals retrieves multiple deals by ID
+//
+// Set input.Archived to read archived deals; active and archived deals can't be read in the same call
 func (c *Client) BatchReadDeals(ctx context.Context, input *BatchReadDealsInput) (*BatchDealsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/deals/batch/read")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 	req.WithBody(input)
+	if input.Archived {
+		req.AddQueryParam("archived", "true")
+	}
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -131,7 +197,7 @@ func (c *Client) BatchReadDeals(ctx context.Context, input *BatchReadDealsInput)
 
 	var batchResp BatchDealsResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -139,10 +205,19 @@ func (c *Client) BatchReadDeals(ctx context.Context, input *BatchReadDealsInput)
 
 // BatchCreateDeals creates multiple deals
 func (c *Client) BatchCreateDeals(ctx context.Context, input *BatchCreateDealsInput) (*BatchDealsResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Deals, withDefaults.Inputs[i].Properties)
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/deals/batch/create")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Deals)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -151,7 +226,7 @@ func (c *Client) BatchCreateDeals(ctx context.Context, input *BatchCreateDealsIn
 
 	var batchResp BatchDealsResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -161,7 +236,7 @@ func (c *Client) BatchCreateDeals(ctx context.Context, input *BatchCreateDealsIn
 func (c *Client) BatchUpdateDeals(ctx context.Context, input *BatchUpdateDealsInput) (*BatchDealsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/deals/batch/update")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -171,28 +246,51 @@ func (c *Client) BatchUpdateDeals(ctx context.Context, input *BatchUpdateDealsIn
 
 	var batchResp BatchDealsResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
 }
 
 // BatchArchiveDeals archives multiple deals
-func (c *Client) BatchArchiveDeals(ctx context.Context, input *BatchArchiveDealsInput) error {
+//
+// If some records could not be archived, the response lists them in Errors and the returned error joins a *BatchError
+// for each entry, so errors.As finds the first and the response holds them all. The other records are still archived
+func (c *Client) BatchArchiveDeals(ctx context.Context, input *BatchArchiveDealsInput) (*BatchArchiveResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/deals/batch/archive")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
 	req.WithBody(input)
 
-	_, err := c.apiClient.Do(ctx, req)
-	return err
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveResp := BatchArchiveResponse{Status: "COMPLETE"}
+	if len(resp.Body) > 0 {
+		if err := json.Unmarshal(resp.Body, &archiveResp); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal batch archive response: %w", tools.DecodeError(err, resp))
+		}
+	}
+
+	return &archiveResp, batchErrors(archiveResp.Errors)
 }
 
 // SearchDeals searches for deals
+//
+// Filters are validated before the request is sent; a *FilterValidationError is returned for malformed filters.
+// Query is a free-text search across the default searchable properties; when set together with FilterGroups, results
+// must match both
 func (c *Client) SearchDeals(ctx context.Context, input *SearchDealsInput) (*SearchDealsResponse, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/deals/search")
 	req.WithContext(ctx)
-	req.WithResourceType("deals")
+	req.WithResourceType(objecttypes.Deals)
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -202,8 +300,133 @@ func (c *Client) SearchDeals(ctx context.Context, input *SearchDealsInput) (*Sea
 
 	var searchResp SearchDealsResponse
 	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &searchResp, nil
 }
+
+// SearchDealsByQuery runs a free-text search for deals matching query and returns the given properties
+func (c *Client) SearchDealsByQuery(ctx context.Context, query string, properties []string) (*SearchDealsResponse, error) {
+	return c.SearchDeals(ctx, &SearchDealsInput{
+		FilterGroups: []FilterGroup{},
+		Sorts:        []string{},
+		Query:        query,
+		Properties:   properties,
+	})
+}
+
+// CountDeals returns how many deals match input without downloading them
+//
+// The search is sent with a limit of 1 and a single property, and the one result is discarded
+func (c *Client) CountDeals(ctx context.Context, input *SearchDealsInput) (int, error) {
+	countInput := *input
+	countInput.Limit = 1
+	countInput.After = ""
+	countInput.Properties = []string{"hs_object_id"}
+	if countInput.FilterGroups == nil {
+		countInput.FilterGroups = []FilterGroup{}
+	}
+	if countInput.Sorts == nil {
+		countInput.Sorts = []string{}
+	}
+
+	searchResp, err := c.SearchDeals(ctx, &countInput)
+	if err != nil {
+		return 0, err
+	}
+
+	return searchResp.Total, nil
+}
+
+// GetDealStageHistory returns the stages deal dealID has moved through, oldest first
+//
+// The transitions come from the history of the dealstage property, so a deal that returned to an earlier stage lists
+// it again. Writes that set dealstage to the value it already had are not transitions and are skipped. Stage IDs can
+// be mapped to labels with the pipelines API
+func (c *Client) GetDealStageHistory(ctx context.Context, dealID string) ([]StageTransition, error) {
+	deal, err := c.GetDeal(ctx, dealID, WithProperties([]string{"dealstage"}), WithPropertiesWithHistory([]string{"dealstage"}))
+	if err != nil {
+		return nil, err
+	}
+
+	history := deal.PropertiesWithHistory["dealstage"]
+	entries := make([]StageTransition, len(history))
+	for i, entry := range history {
+		enteredAt, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dealstage history timestamp %q: %w", entry.Timestamp, err)
+		}
+		entries[i] = StageTransition{Stage: entry.Value, EnteredAt: enteredAt}
+	}
+
+	// HubSpot returns history newest first; sort explicitly rather than rely on it
+	slices.SortStableFunc(entries, func(a, b StageTransition) int {
+		return a.EnteredAt.Compare(b.EnteredAt)
+	})
+
+	var transitions []StageTransition
+	for _, entry := range entries {
+		if n := len(transitions); n > 0 {
+			if transitions[n-1].Stage == entry.Stage {
+				continue
+			}
+			transitions[n-1].ExitedAt = entry.EnteredAt
+		}
+		transitions = append(transitions, entry)
+	}
+
+	return transitions, nil
+}
+
+// CreateDealWithStage creates a deal named name in stage of pipeline, with the given amount
+//
+// The amount is sent as HubSpot expects it, a plain decimal string without exponent or thousands separators, e.g.
+// 1500000.5. Before the deal is created, the stages of pipeline are read from the pipelines API and a
+// *StageNotInPipelineError is returned if stage isn't one of them; a pipeline that doesn't exist is a
+// *client.NotFoundError. Use WithCreateProperty and WithCreateAssociations to set anything else on the deal
+func (c *Client) CreateDealWithStage(ctx context.Context, name, pipeline, stage string, amount float64, opts ...CreateOption) (*Deal, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, fmt.Errorf("invalid deal amount %v", amount)
+	}
+
+	stages, err := c.pipelineStages(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(stages, func(s PipelineStage) bool { return s.ID == stage }) {
+		return nil, &StageNotInPipelineError{Pipeline: pipeline, Stage: stage, Stages: stages}
+	}
+
+	input := &CreateDealInput{Properties: map[string]string{}}
+	for _, opt := range opts {
+		opt(input)
+	}
+	input.Properties["dealname"] = name
+	input.Properties["pipeline"] = pipeline
+	input.Properties["dealstage"] = stage
+	input.Properties["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
+
+	return c.CreateDeal(ctx, input)
+}
+
+// pipelineStages reads the stages of the deal pipeline with ID pipeline
+func (c *Client) pipelineStages(ctx context.Context, pipeline string) ([]PipelineStage, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/pipelines/deals/%s/stages", pipeline))
+	req.WithContext(ctx)
+	req.WithResourceType("pipelines")
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, client.ParseNotFoundError(err, "pipelines", pipeline)
+	}
+
+	var stagesResp struct {
+		Results []PipelineStage `json:"results"`
+	}
+	if err := json.Unmarshal(resp.Body, &stagesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pipeline stages response: %w", tools.DecodeError(err, resp))
+	}
+
+	return stagesResp.Results, nil
+}
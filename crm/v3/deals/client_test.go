@@ -2,11 +2,16 @@ package deals
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -81,6 +86,39 @@ func TestCreateDeal_Success(t *testing.T) {
 	assert.Equal(t, "50000", deal.Properties["amount"])
 }
 
+func TestCreateDeal_WithAssociations(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assocs, ok := body["associations"].([]any)
+		require.True(t, ok)
+		require.Len(t, assocs, 1)
+		assoc := assocs[0].(map[string]any)
+		assert.Equal(t, "101", assoc["to"].(map[string]any)["id"])
+		assocType := assoc["types"].([]any)[0].(map[string]any)
+		assert.Equal(t, "HUBSPOT_DEFINED", assocType["associationCategory"])
+		assert.InDelta(t, 3, assocType["associationTypeId"], 0)
+
+		respondJSON(w, http.StatusCreated, `{"id": "123456", "properties": {}}`)
+	})
+	defer server.Close()
+
+	input := &CreateDealInput{
+		Properties: map[string]string{},
+		Associations: []Association{
+			{
+				To:    AssociationTarget{ID: "101"},
+				Types: []AssociationSpec{{AssociationCategory: "HUBSPOT_DEFINED", AssociationTypeID: 3}},
+			},
+		},
+	}
+
+	_, err := dealsClient.CreateDeal(context.Background(), input)
+
+	require.NoError(t, err)
+}
+
 func TestCreateDeal_Error(t *testing.T) {
 	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "Invalid input"}`)
@@ -121,6 +159,30 @@ func TestGetDeal_Success(t *testing.T) {
 	assert.Equal(t, "Enterprise Deal", deal.Properties["dealname"])
 }
 
+// TestGetDeal_Timestamps tests that timestamps with and without milliseconds are parsed
+func TestGetDeal_Timestamps(t *testing.T) {
+	responseJSON := `{
+		"id": "123456",
+		"properties": {},
+		"createdAt": "2024-01-02T03:04:05.678Z",
+		"updatedAt": "2024-02-03T04:05:06Z",
+		"archived": true,
+		"archivedAt": "2024-03-04T05:06:07.000Z"
+	}`
+
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	deal, err := dealsClient.GetDeal(context.Background(), "123456")
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 678000000, time.UTC), deal.CreatedAt.UTC())
+	assert.Equal(t, time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC), deal.UpdatedAt.UTC())
+	assert.Equal(t, time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC), deal.ArchivedAt.UTC())
+}
+
 func TestGetDeal_WithOptions(t *testing.T) {
 	responseJSON := `{
 		"id": "123456",
@@ -153,6 +215,11 @@ func TestGetDeal_NotFound(t *testing.T) {
 
 	_, err := dealsClient.GetDeal(context.Background(), "999999")
 	require.Error(t, err)
+
+	var notFoundErr *client.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "deals", notFoundErr.ObjectType)
+	assert.Equal(t, "999999", notFoundErr.ObjectID)
 }
 
 // TestUpdateDeal tests updating a deal
@@ -304,6 +371,36 @@ func TestBatchReadDeals_Success(t *testing.T) {
 	assert.Len(t, resp.Results, 1)
 }
 
+// TestBatchReadDeals_Archived tests that Archived is sent as a query parameter and not in the body
+func TestBatchReadDeals_Archived(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.NotContains(t, body, "archived")
+		assert.NotContains(t, body, "Archived")
+
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "1", "archived": true}]}`)
+	})
+	defer server.Close()
+
+	input := &BatchReadDealsInput{
+		Inputs: []struct {
+			ID string `json:"id"`
+		}{
+			{ID: "1"},
+		},
+		Archived: true,
+	}
+
+	resp, err := dealsClient.BatchReadDeals(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.True(t, resp.Results[0].Archived)
+}
+
 // TestBatchCreateDeals tests batch create
 func TestBatchCreateDeals_Success(t *testing.T) {
 	responseJSON := `{
@@ -374,9 +471,41 @@ func TestBatchArchiveDeals_Success(t *testing.T) {
 	defer server.Close()
 
 	input := &BatchArchiveDealsInput{}
-	err := dealsClient.BatchArchiveDeals(context.Background(), input)
+	resp, err := dealsClient.BatchArchiveDeals(context.Background(), input)
 
 	require.NoError(t, err)
+	assert.Equal(t, "COMPLETE", resp.Status)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestBatchArchiveDeals_PartialFailure tests that records which failed to archive are reported
+func TestBatchArchiveDeals_PartialFailure(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusMultiStatus, `{
+			"status": "COMPLETE",
+			"numErrors": 2,
+			"errors": [
+				{"status": "error", "category": "OBJECT_NOT_FOUND", "message": "Could not find records", "context": {"ids": ["3", "4"]}},
+				{"status": "error", "category": "VALIDATION_ERROR", "message": "Record is locked", "context": {"ids": ["7"]}}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	input := &BatchArchiveDealsInput{}
+	resp, err := dealsClient.BatchArchiveDeals(context.Background(), input)
+
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 2, resp.NumErrors)
+	require.Len(t, resp.Errors, 2)
+	assert.Equal(t, []string{"3", "4"}, resp.Errors[0].IDs())
+	assert.Equal(t, []string{"7"}, resp.Errors[1].IDs())
+
+	var batchErr *BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, "OBJECT_NOT_FOUND", batchErr.Category)
+	assert.Contains(t, err.Error(), "Record is locked (ids: 7)")
 }
 
 // TestSearchDeals tests search functionality
@@ -426,6 +555,71 @@ func TestSearchDeals_Success(t *testing.T) {
 	assert.Len(t, resp.Results, 1)
 }
 
+// TestSearchDealsByQuery tests that the free-text query is sent in the request body
+func TestSearchDealsByQuery(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Renewal", body["query"])
+		assert.Equal(t, []any{"dealname"}, body["properties"])
+		assert.Equal(t, []any{}, body["filterGroups"])
+
+		respondJSON(w, http.StatusOK, `{"total": 1, "results": [{"id": "1", "properties": {"dealname": "Renewal"}, "archived": false}]}`)
+	})
+	defer server.Close()
+
+	resp, err := dealsClient.SearchDealsByQuery(context.Background(), "Renewal", []string{"dealname"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, "1", resp.Results[0].ID)
+}
+
+// TestCountDeals tests that counting sends a minimal search and returns the total
+func TestCountDeals(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body SearchDealsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 1, body.Limit)
+		assert.Equal(t, []string{"hs_object_id"}, body.Properties)
+		assert.Equal(t, "EQ", string(body.FilterGroups[0].Filters[0].Operator))
+
+		respondJSON(w, http.StatusOK, `{"total": 1234, "results": [{"id": "1", "properties": {}, "archived": false}]}`)
+	})
+	defer server.Close()
+
+	count, err := dealsClient.CountDeals(context.Background(), &SearchDealsInput{
+		FilterGroups: []FilterGroup{{Filters: []Filter{{PropertyName: "dealstage", Operator: "EQ", Value: "closedwon"}}}},
+		Properties:   []string{"dealname", "amount"},
+		Limit:        100,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1234, count)
+}
+
+// TestSearchDeals_InvalidFilter tests that malformed filters are rejected before sending
+func TestSearchDeals_InvalidFilter(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	input := &SearchDealsInput{
+		FilterGroups: []FilterGroup{
+			{Filters: []Filter{{PropertyName: "amount", Operator: Between, Value: "100"}}},
+		},
+	}
+
+	_, err := dealsClient.SearchDeals(context.Background(), input)
+
+	var validationErr *FilterValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "amount", validationErr.PropertyName)
+}
+
 // TestOptions tests all option functions
 func TestOptions(t *testing.T) {
 	tests := []struct {
@@ -463,3 +657,196 @@ func TestOptions(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
+
+// TestGetDealStageHistory tests building ordered stage transitions from dealstage history
+func TestGetDealStageHistory(t *testing.T) {
+	responseJSON := `{
+		"id": "42",
+		"properties": {"dealstage": "closedwon"},
+		"propertiesWithHistory": {
+			"dealstage": [
+				{"value": "closedwon", "timestamp": "2024-03-01T00:00:00Z", "sourceType": "CRM_UI"},
+				{"value": "presentationscheduled", "timestamp": "2024-02-10T00:00:00Z", "sourceType": "API"},
+				{"value": "presentationscheduled", "timestamp": "2024-02-01T00:00:00.000Z", "sourceType": "CRM_UI"},
+				{"value": "appointmentscheduled", "timestamp": "2024-01-01T00:00:00Z", "sourceType": "CRM_UI"}
+			]
+		}
+	}`
+
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/42", r.URL.Path)
+		assert.Equal(t, "dealstage", r.URL.Query().Get("propertiesWithHistory"))
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	transitions, err := dealsClient.GetDealStageHistory(context.Background(), "42")
+
+	require.NoError(t, err)
+	assert.Equal(t, []StageTransition{
+		{
+			Stage:     "appointmentscheduled",
+			EnteredAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExitedAt:  time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Stage:     "presentationscheduled",
+			EnteredAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			ExitedAt:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Stage:     "closedwon",
+			EnteredAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}, transitions)
+}
+
+// TestGetDealStageHistory_BadTimestamp tests that an unparseable history timestamp is reported
+func TestGetDealStageHistory_BadTimestamp(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"id": "42", "propertiesWithHistory": {"dealstage": [{"value": "a", "timestamp": "yesterday"}]}}`)
+	})
+	defer server.Close()
+
+	_, err := dealsClient.GetDealStageHistory(context.Background(), "42")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "yesterday")
+}
+
+// dealStagesServer serves the stages of the "default" pipeline and records the body of the created deal
+func dealStagesServer(t *testing.T, created *CreateDealInput) (*httptest.Server, *Client) {
+	return setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/crm/v3/pipelines/deals/default/stages":
+			respondJSON(w, http.StatusOK, `{"results": [
+				{"id": "appointmentscheduled", "label": "Appointment Scheduled"},
+				{"id": "closedwon", "label": "Closed Won"}
+			]}`)
+		case r.Method == "GET":
+			respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "Pipeline not found"}`)
+		case r.Method == "POST" && r.URL.Path == "/crm/v3/objects/deals":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			respondJSON(w, http.StatusCreated, `{"id": "1", "properties": {"dealname": "Big deal"}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+// TestCreateDealWithStage_Success tests creating a deal after checking its stage, with the amount formatted
+func TestCreateDealWithStage_Success(t *testing.T) {
+	var created CreateDealInput
+	server, dealsClient := dealStagesServer(t, &created)
+	defer server.Close()
+
+	deal, err := dealsClient.CreateDealWithStage(context.Background(), "Big deal", "default", "closedwon", 1234567.5,
+		WithCreateProperty("closedate", "2024-06-30"),
+		WithCreateProperty("dealstage", "ignored"),
+		WithCreateAssociations(Association{To: AssociationTarget{ID: "42"}}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", deal.ID)
+	assert.Equal(t, map[string]string{
+		"dealname":  "Big deal",
+		"pipeline":  "default",
+		"dealstage": "closedwon",
+		"amount":    "1234567.5",
+		"closedate": "2024-06-30",
+	}, created.Properties)
+	require.Len(t, created.Associations, 1)
+	assert.Equal(t, "42", created.Associations[0].To.ID)
+}
+
+// TestCreateDealWithStage_StageNotInPipeline tests that an unknown stage is rejected before the deal is created
+func TestCreateDealWithStage_StageNotInPipeline(t *testing.T) {
+	var created CreateDealInput
+	server, dealsClient := dealStagesServer(t, &created)
+	defer server.Close()
+
+	_, err := dealsClient.CreateDealWithStage(context.Background(), "Big deal", "default", "contractsent", 100)
+
+	var stageErr *StageNotInPipelineError
+	require.ErrorAs(t, err, &stageErr)
+	assert.Equal(t, "contractsent", stageErr.Stage)
+	assert.Len(t, stageErr.Stages, 2)
+	assert.Nil(t, created.Properties)
+}
+
+// TestCreateDealWithStage_UnknownPipeline tests that a missing pipeline is reported as a not-found error
+func TestCreateDealWithStage_UnknownPipeline(t *testing.T) {
+	var created CreateDealInput
+	server, dealsClient := dealStagesServer(t, &created)
+	defer server.Close()
+
+	_, err := dealsClient.CreateDealWithStage(context.Background(), "Big deal", "enterprise", "closedwon", 100)
+
+	var notFoundErr *client.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "pipelines", notFoundErr.ObjectType)
+	assert.Equal(t, "enterprise", notFoundErr.ObjectID)
+
+	_, err = dealsClient.CreateDealWithStage(context.Background(), "Big deal", "default", "closedwon", math.NaN())
+	require.Error(t, err)
+}
+
+// TestListAllDeals_FollowsCursor tests that every page is read with the caller's options until paging ends
+func TestListAllDeals_FollowsCursor(t *testing.T) {
+	var afters []string
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		assert.Equal(t, "name", r.URL.Query().Get("properties"))
+		afters = append(afters, r.URL.Query().Get("after"))
+		switch r.URL.Query().Get("after") {
+		case "":
+			respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`)
+		case "2":
+			respondJSON(w, http.StatusOK, `{"results": [{"id": "3"}]}`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("after"))
+		}
+	})
+	defer server.Close()
+
+	results, err := dealsClient.ListAllDeals(context.Background(), WithLimit(2), WithProperties([]string{"name"}))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "3", results[2].ID)
+	assert.Equal(t, []string{"", "2"}, afters)
+}
+
+// TestForEachDeal_StopsOnError tests that an error from the callback stops paging and is returned
+func TestForEachDeal_StopsOnError(t *testing.T) {
+	requests := 0
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`)
+	})
+	defer server.Close()
+
+	stop := errors.New("stop")
+	seen := 0
+	err := dealsClient.ForEachDeal(context.Background(), func(deal Deal) error {
+		seen++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, seen)
+	assert.Equal(t, 1, requests)
+}
+
+// TestListAllDeals_RepeatedCursor tests that a page repeating the previous cursor ends paging with an error
+func TestListAllDeals_RepeatedCursor(t *testing.T) {
+	server, dealsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"results": [{"id": "1"}], "paging": {"next": {"after": "a"}}}`)
+	})
+	defer server.Close()
+
+	_, err := dealsClient.ListAllDeals(context.Background())
+	assert.ErrorIs(t, err, client.ErrTooManyPages)
+}
@@ -0,0 +1,62 @@
+package deals
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFilterValidationError_Error tests the Error() method
+func TestFilterValidationError_Error(t *testing.T) {
+	err := &FilterValidationError{
+		PropertyName: "amount",
+		Operator:     Between,
+		Message:      "requires Value and HighValue",
+	}
+
+	assert.Equal(t, "invalid filter on property amount: operator BETWEEN requires Value and HighValue", err.Error())
+}
+
+// TestBatchError_Error tests the Error() method with and without record IDs
+func TestBatchError_Error(t *testing.T) {
+	err := &BatchError{
+		Category: "OBJECT_NOT_FOUND",
+		Message:  "Could not find records",
+		Context:  map[string][]string{"ids": {"3", "4"}},
+	}
+	assert.Equal(t, "OBJECT_NOT_FOUND: Could not find records (ids: 3, 4)", err.Error())
+
+	err = &BatchError{Category: "VALIDATION_ERROR", Message: "Invalid input"}
+	assert.Equal(t, "VALIDATION_ERROR: Invalid input", err.Error())
+}
+
+// TestFilterValidate tests operator-specific filter validation
+func TestFilterValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantErr bool
+	}{
+		{"EQ with value", Filter{PropertyName: "name", Operator: EQ, Value: "Acme"}, false},
+		{"EQ without value", Filter{PropertyName: "name", Operator: EQ}, true},
+		{"BETWEEN with both values", Filter{PropertyName: "amount", Operator: Between, Value: 1, HighValue: 10}, false},
+		{"BETWEEN without high value", Filter{PropertyName: "amount", Operator: Between, Value: 1}, true},
+		{"IN with values", Filter{PropertyName: "stage", Operator: In, Values: []any{"a", "b"}}, false},
+		{"IN without values", Filter{PropertyName: "stage", Operator: In}, true},
+		{"NOT_IN without values", Filter{PropertyName: "stage", Operator: NotIn}, true},
+		{"HAS_PROPERTY", Filter{PropertyName: "name", Operator: HasProperty}, false},
+		{"Unknown operator", Filter{PropertyName: "name", Operator: "EQUALS", Value: "x"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				var validationErr *FilterValidationError
+				assert.ErrorAs(t, err, &validationErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
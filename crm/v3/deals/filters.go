@@ -0,0 +1,129 @@
+package deals
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+)
+
+// FilterGroupsFromObjects converts search filter groups built for the objects client into deals filter groups
+//
+// Together with ObjectsFilterGroups this lets one piece of search-building code serve the objects, companies and
+// deals clients: build the filters once with either package's types and convert them for the others
+func FilterGroupsFromObjects(groups []objects.SearchFilterGroup) []FilterGroup {
+	if groups == nil {
+		return nil
+	}
+
+	converted := make([]FilterGroup, len(groups))
+	for i, group := range groups {
+		filters := make([]Filter, len(group.Filters))
+		for j, f := range group.Filters {
+			filters[j] = Filter{
+				PropertyName: f.PropertyName,
+				Operator:     FilterOperator(f.Operator),
+			}
+			if f.Value != "" {
+				filters[j].Value = f.Value
+			}
+			if f.HighValue != "" {
+				filters[j].HighValue = f.HighValue
+			}
+			for _, v := range f.Values {
+				filters[j].Values = append(filters[j].Values, v)
+			}
+		}
+		converted[i] = FilterGroup{Filters: filters}
+	}
+	return converted
+}
+
+// ObjectsFilterGroups converts deals filter groups into search filter groups for the objects client
+//
+// The objects client takes filter values as strings, so values are formatted with formatFilterValue; a nil value
+// becomes the empty string
+func ObjectsFilterGroups(groups []FilterGroup) []objects.SearchFilterGroup {
+	if groups == nil {
+		return nil
+	}
+
+	converted := make([]objects.SearchFilterGroup, len(groups))
+	for i, group := range groups {
+		filters := make([]objects.SearchFilter, len(group.Filters))
+		for j, f := range group.Filters {
+			filters[j] = objects.SearchFilter{
+				PropertyName: f.PropertyName,
+				Operator:     objects.FilterOperator(f.Operator),
+				Value:        formatFilterValue(f.Value),
+				HighValue:    formatFilterValue(f.HighValue),
+			}
+			for _, v := range f.Values {
+				filters[j].Values = append(filters[j].Values, formatFilterValue(v))
+			}
+		}
+		converted[i] = objects.SearchFilterGroup{Filters: filters}
+	}
+	return converted
+}
+
+// MarshalJSON sends Value, HighValue and Values as strings, formatted with formatFilterValue
+//
+// HubSpot's search API compares filter values as strings, and a JSON number or boolean can silently match nothing,
+// so an int, bool or time.Time can be used as a value without converting it first. Nil values are omitted
+func (f Filter) MarshalJSON() ([]byte, error) {
+	type filterJSON struct {
+		PropertyName string         `json:"propertyName"`
+		Operator     FilterOperator `json:"operator"`
+		Value        *string        `json:"value,omitempty"`
+		HighValue    *string        `json:"highValue,omitempty"`
+		Values       []string       `json:"values,omitempty"`
+	}
+
+	out := filterJSON{
+		PropertyName: f.PropertyName,
+		Operator:     f.Operator,
+		Value:        filterValueJSON(f.Value),
+		HighValue:    filterValueJSON(f.HighValue),
+	}
+	for _, v := range f.Values {
+		out.Values = append(out.Values, formatFilterValue(v))
+	}
+	return json.Marshal(out)
+}
+
+// filterValueJSON formats v with formatFilterValue, returning nil for a nil value so it is omitted
+func filterValueJSON(v any) *string {
+	if v == nil {
+		return nil
+	}
+	s := formatFilterValue(v)
+	return &s
+}
+
+// formatFilterValue formats a filter value the way it is sent to HubSpot, without exponents for large numbers
+//
+// Times are sent as Unix milliseconds, which HubSpot expects for date and datetime properties
+func formatFilterValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case time.Time:
+		return strconv.FormatInt(value.UnixMilli(), 10)
+	case *time.Time:
+		if value == nil {
+			return ""
+		}
+		return strconv.FormatInt(value.UnixMilli(), 10)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', -1, 32)
+	default:
+		return fmt.Sprint(value)
+	}
+}
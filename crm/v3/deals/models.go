@@ -1,5 +1,7 @@
 package deals
 
+import "time"
+
 type FilterOperator string
 
 const (
@@ -23,10 +25,23 @@ type Deal struct {
 	ID                    string                           `json:"id"`
 	Properties            map[string]string                `json:"properties"`
 	PropertiesWithHistory map[string][]PropertyWithHistory `json:"propertiesWithHistory"`
-	CreatedAt             string                           `json:"createdAt"`
-	UpdatedAt             string                           `json:"updatedAt"`
+	CreatedAt             time.Time                        `json:"createdAt"`
+	UpdatedAt             time.Time                        `json:"updatedAt"`
 	Archived              bool                             `json:"archived"`
-	ArchivedAt            string                           `json:"archivedAt"`
+	ArchivedAt            time.Time                        `json:"archivedAt"`
+}
+
+// StageTransition is one entry of a deal's stage history
+type StageTransition struct {
+	Stage     string    // Deal stage ID, as stored in dealstage
+	EnteredAt time.Time // When the deal moved into Stage
+	ExitedAt  time.Time // When the deal moved out of Stage; zero for the current stage
+}
+
+// PipelineStage is a stage of a deal pipeline
+type PipelineStage struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
 }
 
 // PropertyWithHistory represents a property with its historical values
@@ -41,7 +56,25 @@ type PropertyWithHistory struct {
 
 // CreateDealInput represents the input for creating a deal
 type CreateDealInput struct {
-	Properties map[string]string `json:"properties"`
+	Properties   map[string]string `json:"properties"`
+	Associations []Association     `json:"associations,omitempty"`
+}
+
+// Association associates a new deal to an existing record in the create request
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// AssociationTarget identifies the record to associate to
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationSpec defines the type of an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
 }
 
 // UpdateDealInput represents the input for updating a deal
@@ -75,6 +108,9 @@ type BatchReadDealsInput struct {
 	Inputs                []struct {
 		ID string `json:"id"`
 	} `json:"inputs"`
+
+	// Archived reads archived deals instead of active ones. It is sent as the archived query parameter
+	Archived bool `json:"-"`
 }
 
 // BatchCreateDealsInput represents input for batch create
@@ -97,6 +133,18 @@ type BatchArchiveDealsInput struct {
 	} `json:"inputs"`
 }
 
+// BatchArchiveResponse reports the outcome of a batch archive
+//
+// HubSpot answers an archive in which every record succeeded with no body; the response then has Status COMPLETE and
+// no Errors
+type BatchArchiveResponse struct {
+	Status      string       `json:"status"`
+	NumErrors   int          `json:"numErrors"`
+	Errors      []BatchError `json:"errors"`
+	StartedAt   string       `json:"startedAt"`
+	CompletedAt string       `json:"completedAt"`
+}
+
 // BatchDealsResponse represents response from batch operations
 type BatchDealsResponse struct {
 	Status      string `json:"status"`
@@ -115,16 +163,64 @@ type SearchDealsInput struct {
 	After        string        `json:"after"`
 }
 
+// Validate checks every filter in the search input
+func (in *SearchDealsInput) Validate() error {
+	for _, group := range in.FilterGroups {
+		for _, filter := range group.Filters {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // FilterGroup represents a group of filters
 type FilterGroup struct {
 	Filters []Filter `json:"filters"`
 }
 
 // Filter represents a single filter
+//
+// BETWEEN filters require HighValue, IN and NOT_IN filters require Values. Values may be strings, numbers, booleans
+// or time.Time; they are sent to HubSpot as strings, see MarshalJSON
 type Filter struct {
-	PropertyName string `json:"propertyName"`
-	Operator     string `json:"operator"`
-	Value        any    `json:"value"`
+	PropertyName string         `json:"propertyName"`
+	Operator     FilterOperator `json:"operator"`
+	Value        any            `json:"value,omitempty"`
+	HighValue    any            `json:"highValue,omitempty"`
+	Values       []any          `json:"values,omitempty"`
+}
+
+// Validate checks that the filter has the values its operator requires
+func (f Filter) Validate() error {
+	switch f.Operator {
+	case EQ, NEQ, LT, LTE, GT, GTE, ContainsToken, NotContainsToken:
+		if isEmptyFilterValue(f.Value) {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Value"}
+		}
+	case Between:
+		if isEmptyFilterValue(f.Value) || isEmptyFilterValue(f.HighValue) {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Value and HighValue"}
+		}
+	case In, NotIn:
+		if len(f.Values) == 0 {
+			return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "requires Values"}
+		}
+	case HasProperty, NotHasProperty:
+	default:
+		return &FilterValidationError{PropertyName: f.PropertyName, Operator: f.Operator, Message: "unknown operator"}
+	}
+	return nil
+}
+
+// isEmptyFilterValue reports whether a filter value is unset
+func isEmptyFilterValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	s, ok := v.(string)
+	return ok && s == ""
 }
 
 // SearchDealsResponse represents response from search
@@ -10,10 +10,10 @@ import (
 // DealOption represents a functional option for deal requests
 type DealOption func(*client.Request)
 
-// WithProperties specifies which properties to return
+// WithProperties specifies which properties to return; repeated calls add to the list
 func WithProperties(properties []string) DealOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("properties", strings.Join(properties, ","))
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
 	}
 }
 
@@ -24,10 +24,10 @@ func WithPropertiesWithHistory(properties []string) DealOption {
 	}
 }
 
-// WithAssociations specifies which associations to return
+// WithAssociations specifies which associations to return; repeated calls add to the list
 func WithAssociations(associations []string) DealOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("associations", strings.Join(associations, ","))
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
 	}
 }
 
@@ -58,3 +58,20 @@ func WithIDProperty(property string) DealOption {
 		req.AddQueryParam("idProperty", property)
 	}
 }
+
+// CreateOption sets additional fields of the deal created by CreateDealWithStage
+type CreateOption func(*CreateDealInput)
+
+// WithCreateProperty sets a property of the created deal; the properties CreateDealWithStage sets itself win
+func WithCreateProperty(name, value string) CreateOption {
+	return func(input *CreateDealInput) {
+		input.Properties[name] = value
+	}
+}
+
+// WithCreateAssociations associates the created deal with existing records
+func WithCreateAssociations(associations ...Association) CreateOption {
+	return func(input *CreateDealInput) {
+		input.Associations = append(input.Associations, associations...)
+	}
+}
@@ -0,0 +1,21 @@
+package engagements
+
+import "context"
+
+// API is the set of engagements client methods, implemented by *Client
+//
+// Code that calls the engagements API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateEngagement(ctx context.Context, engagementType EngagementType, input *CreateEngagementInput) (*Engagement, error)
+	GetEngagement(ctx context.Context, engagementType EngagementType, engagementID string, opts ...EngagementOption) (*Engagement, error)
+	UpdateEngagement(ctx context.Context, engagementType EngagementType, engagementID string, input *UpdateEngagementInput) (*Engagement, error)
+	ArchiveEngagement(ctx context.Context, engagementType EngagementType, engagementID string) error
+	ListEngagements(ctx context.Context, engagementType EngagementType, opts ...EngagementOption) (*ListEngagementsResponse, error)
+	BatchReadEngagements(ctx context.Context, engagementType EngagementType, input *BatchReadEngagementsInput) (*BatchEngagementsResponse, error)
+	BatchCreateEngagements(ctx context.Context, engagementType EngagementType, input *BatchCreateEngagementsInput) (*BatchEngagementsResponse, error)
+	BatchUpdateEngagements(ctx context.Context, engagementType EngagementType, input *BatchUpdateEngagementsInput) (*BatchEngagementsResponse, error)
+	BatchArchiveEngagements(ctx context.Context, engagementType EngagementType, input *BatchArchiveEngagementsInput) error
+}
+
+var _ API = (*Client)(nil)
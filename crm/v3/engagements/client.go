@@ -0,0 +1,207 @@
+// Package engagements provides client methods for the HubSpot CRM Engagements APIs (notes, calls, emails, meetings and tasks)
+package engagements
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
+)
+
+// Client represents the Engagements API client
+type Client struct {
+	apiClient *client.Client
+}
+
+// NewClient creates a new engagements client
+func NewClient(apiClient *client.Client) *Client {
+	return &Client{
+		apiClient: apiClient,
+	}
+}
+
+// CreateEngagement creates a new engagement, optionally associating it to existing records
+func (c *Client) CreateEngagement(ctx context.Context, engagementType EngagementType, input *CreateEngagementInput) (*Engagement, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(string(engagementType), input.Properties)
+
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var engagement Engagement
+	if err := json.Unmarshal(resp.Body, &engagement); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal engagement response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &engagement, nil
+}
+
+// GetEngagement retrieves an engagement by ID
+func (c *Client) GetEngagement(ctx context.Context, engagementType EngagementType, engagementID string, opts ...EngagementOption) (*Engagement, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s/%s", engagementType, engagementID))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, client.ParseNotFoundError(err, string(engagementType), engagementID)
+	}
+
+	var engagement Engagement
+	if err := json.Unmarshal(resp.Body, &engagement); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal engagement response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &engagement, nil
+}
+
+// UpdateEngagement updates an engagement
+func (c *Client) UpdateEngagement(ctx context.Context, engagementType EngagementType, engagementID string, input *UpdateEngagementInput) (*Engagement, error) {
+	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/%s/%s", engagementType, engagementID))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var engagement Engagement
+	if err := json.Unmarshal(resp.Body, &engagement); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal engagement response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &engagement, nil
+}
+
+// ArchiveEngagement archives (deletes) an engagement
+func (c *Client) ArchiveEngagement(ctx context.Context, engagementType EngagementType, engagementID string) error {
+	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/%s/%s", engagementType, engagementID))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
+
+// ListEngagements lists engagements of a type with optional filters
+func (c *Client) ListEngagements(ctx context.Context, engagementType EngagementType, opts ...EngagementOption) (*ListEngagementsResponse, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithPaging()
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var listResp ListEngagementsResponse
+	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal engagements list response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &listResp, nil
+}
+
+// BatchReadEngagements retrieves multiple engagements by ID
+func (c *Client) BatchReadEngagements(ctx context.Context, engagementType EngagementType, input *BatchReadEngagementsInput) (*BatchEngagementsResponse, error) {
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/read", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchEngagementsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchCreateEngagements creates multiple engagements
+func (c *Client) BatchCreateEngagements(ctx context.Context, engagementType EngagementType, input *BatchCreateEngagementsInput) (*BatchEngagementsResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(string(engagementType), withDefaults.Inputs[i].Properties)
+	}
+
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/create", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchEngagementsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchUpdateEngagements updates multiple engagements
+func (c *Client) BatchUpdateEngagements(ctx context.Context, engagementType EngagementType, input *BatchUpdateEngagementsInput) (*BatchEngagementsResponse, error) {
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/update", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchEngagementsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchArchiveEngagements archives multiple engagements
+func (c *Client) BatchArchiveEngagements(ctx context.Context, engagementType EngagementType, input *BatchArchiveEngagementsInput) error {
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/archive", engagementType))
+	req.WithContext(ctx)
+	req.WithResourceType("engagements")
+	req.WithBody(input)
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
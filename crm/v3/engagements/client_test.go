@@ -0,0 +1,259 @@
+package engagements
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper functions
+func setupMockServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(handler))
+
+	apiClient, err := client.NewClient(
+		client.WithBaseURL(server.URL),
+		client.WithAccessToken("test-token"),
+		client.WithRateLimitEnabled(false),
+		client.WithRetryEnabled(false),
+	)
+	require.NoError(t, err)
+
+	engagementsClient := NewClient(apiClient)
+	return server, engagementsClient
+}
+
+func respondJSON(w http.ResponseWriter, statusCode int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(body))
+}
+
+const noteJSON = `{
+	"id": "555",
+	"properties": {
+		"hs_note_body": "Spoke with Jane",
+		"hs_timestamp": "2024-01-01T12:00:00.000Z"
+	},
+	"createdAt": "2024-01-01T12:00:00.000Z",
+	"updatedAt": "2024-01-01T12:00:00.000Z",
+	"archived": false
+}`
+
+// TestNewClient tests client creation
+func TestNewClient(t *testing.T) {
+	apiClient, err := client.NewClient()
+	require.NoError(t, err)
+
+	engagementsClient := NewClient(apiClient)
+	assert.NotNil(t, engagementsClient)
+	assert.NotNil(t, engagementsClient.apiClient)
+}
+
+// TestCreateEngagement_WithAssociations tests creating a note associated to a contact
+func TestCreateEngagement_WithAssociations(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/notes", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		props := body["properties"].(map[string]any)
+		assert.Equal(t, "Spoke with Jane", props["hs_note_body"])
+		assert.Equal(t, "2024-01-01T12:00:00.000Z", props["hs_timestamp"])
+
+		assocs := body["associations"].([]any)
+		require.Len(t, assocs, 1)
+		assoc := assocs[0].(map[string]any)
+		assert.Equal(t, "101", assoc["to"].(map[string]any)["id"])
+		assocType := assoc["types"].([]any)[0].(map[string]any)
+		assert.Equal(t, "HUBSPOT_DEFINED", assocType["associationCategory"])
+		assert.InDelta(t, 202, assocType["associationTypeId"], 0)
+
+		respondJSON(w, http.StatusCreated, noteJSON)
+	})
+	defer server.Close()
+
+	input := NewNoteInput("Spoke with Jane", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), Association{
+		To: AssociationTarget{ID: "101"},
+		Types: []AssociationSpec{{
+			AssociationCategory: AssociationCategoryHubSpotDefined,
+			AssociationTypeID:   202,
+		}},
+	})
+
+	note, err := engagementsClient.CreateEngagement(context.Background(), Notes, input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "555", note.ID)
+	assert.Equal(t, "Spoke with Jane", note.Properties[PropertyNoteBody])
+}
+
+func TestCreateEngagement_Error(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "hs_timestamp is required"}`)
+	})
+	defer server.Close()
+
+	_, err := engagementsClient.CreateEngagement(context.Background(), Calls, &CreateEngagementInput{Properties: map[string]string{}})
+
+	require.Error(t, err)
+}
+
+// TestGetEngagement tests retrieving an engagement
+func TestGetEngagement_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/crm/v3/objects/notes/555", r.URL.Path)
+		assert.Equal(t, "hs_note_body", r.URL.Query().Get("properties"))
+		assert.Equal(t, "contacts", r.URL.Query().Get("associations"))
+		respondJSON(w, http.StatusOK, noteJSON)
+	})
+	defer server.Close()
+
+	note, err := engagementsClient.GetEngagement(context.Background(), Notes, "555",
+		WithProperties([]string{"hs_note_body"}),
+		WithAssociations([]string{"contacts"}),
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "555", note.ID)
+}
+
+// TestUpdateEngagement tests updating an engagement
+func TestUpdateEngagement_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/crm/v3/objects/tasks/777", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"id": "777", "properties": {"hs_task_status": "COMPLETED"}}`)
+	})
+	defer server.Close()
+
+	task, err := engagementsClient.UpdateEngagement(context.Background(), Tasks, "777", &UpdateEngagementInput{
+		Properties: map[string]string{PropertyTaskStatus: "COMPLETED"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", task.Properties[PropertyTaskStatus])
+}
+
+// TestArchiveEngagement tests archiving an engagement
+func TestArchiveEngagement_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/crm/v3/objects/meetings/888", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	err := engagementsClient.ArchiveEngagement(context.Background(), Meetings, "888")
+
+	require.NoError(t, err)
+}
+
+// TestListEngagements tests listing engagements
+func TestListEngagements_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/crm/v3/objects/emails", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		respondJSON(w, http.StatusOK, `{
+			"results": [{"id": "1"}, {"id": "2"}],
+			"paging": {"next": {"after": "2"}}
+		}`)
+	})
+	defer server.Close()
+
+	resp, err := engagementsClient.ListEngagements(context.Background(), Emails, WithLimit(10))
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Results, 2)
+	assert.Equal(t, "2", resp.Paging.Next.After)
+}
+
+// TestBatchCreateEngagements tests batch creation
+func TestBatchCreateEngagements_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/notes/batch/create", r.URL.Path)
+		respondJSON(w, http.StatusCreated, `{"status": "COMPLETE", "results": [{"id": "1"}, {"id": "2"}]}`)
+	})
+	defer server.Close()
+
+	now := time.Now()
+	input := &BatchCreateEngagementsInput{
+		Inputs: []CreateEngagementInput{
+			*NewNoteInput("first", now),
+			*NewNoteInput("second", now),
+		},
+	}
+
+	resp, err := engagementsClient.BatchCreateEngagements(context.Background(), Notes, input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETE", resp.Status)
+	assert.Len(t, resp.Results, 2)
+}
+
+// TestBatchReadEngagements tests batch reading
+func TestBatchReadEngagements_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/calls/batch/read", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "1"}]}`)
+	})
+	defer server.Close()
+
+	input := &BatchReadEngagementsInput{Properties: []string{PropertyCallTitle}}
+	input.Inputs = append(input.Inputs, struct {
+		ID string `json:"id"`
+	}{ID: "1"})
+
+	resp, err := engagementsClient.BatchReadEngagements(context.Background(), Calls, input)
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Results, 1)
+}
+
+// TestBatchArchiveEngagements tests batch archiving
+func TestBatchArchiveEngagements_Success(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/tasks/batch/archive", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	err := engagementsClient.BatchArchiveEngagements(context.Background(), Tasks, &BatchArchiveEngagementsInput{})
+
+	require.NoError(t, err)
+}
+
+// TestInvalidJSON tests handling of malformed responses
+func TestInvalidJSON(t *testing.T) {
+	server, engagementsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `invalid json`)
+	})
+	defer server.Close()
+
+	_, err := engagementsClient.GetEngagement(context.Background(), Notes, "1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal")
+}
+
+// TestFormatTimestamp tests hs_timestamp formatting
+func TestFormatTimestamp(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 9, 30, 15, 250*int(time.Millisecond), time.FixedZone("EST", -5*3600))
+	assert.Equal(t, "2024-03-05T14:30:15.250Z", FormatTimestamp(ts))
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
@@ -0,0 +1,169 @@
+package engagements
+
+import "time"
+
+// EngagementType is the object type name of an engagement
+type EngagementType string
+
+const (
+	Notes    EngagementType = "notes"
+	Calls    EngagementType = "calls"
+	Emails   EngagementType = "emails"
+	Meetings EngagementType = "meetings"
+	Tasks    EngagementType = "tasks"
+)
+
+// Common engagement property names
+const (
+	// PropertyTimestamp is required on every engagement and controls where it appears on the record timeline
+	PropertyTimestamp = "hs_timestamp"
+	PropertyOwnerID   = "hubspot_owner_id"
+
+	PropertyNoteBody = "hs_note_body"
+
+	PropertyCallTitle     = "hs_call_title"
+	PropertyCallBody      = "hs_call_body"
+	PropertyCallDirection = "hs_call_direction"
+	PropertyCallDuration  = "hs_call_duration"
+
+	PropertyEmailSubject   = "hs_email_subject"
+	PropertyEmailText      = "hs_email_text"
+	PropertyEmailDirection = "hs_email_direction"
+
+	PropertyMeetingTitle     = "hs_meeting_title"
+	PropertyMeetingBody      = "hs_meeting_body"
+	PropertyMeetingStartTime = "hs_meeting_start_time"
+	PropertyMeetingEndTime   = "hs_meeting_end_time"
+
+	PropertyTaskSubject  = "hs_task_subject"
+	PropertyTaskBody     = "hs_task_body"
+	PropertyTaskStatus   = "hs_task_status"
+	PropertyTaskPriority = "hs_task_priority"
+)
+
+// Association category constants
+const (
+	AssociationCategoryHubSpotDefined    = "HUBSPOT_DEFINED"
+	AssociationCategoryIntegratorDefined = "INTEGRATOR_DEFINED"
+	AssociationCategoryUserDefined       = "USER_DEFINED"
+)
+
+// Engagement represents a HubSpot engagement object (note, call, email, meeting or task)
+type Engagement struct {
+	ID                    string                           `json:"id"`
+	Properties            map[string]string                `json:"properties"`
+	PropertiesWithHistory map[string][]PropertyWithHistory `json:"propertiesWithHistory"`
+	CreatedAt             string                           `json:"createdAt"`
+	UpdatedAt             string                           `json:"updatedAt"`
+	Archived              bool                             `json:"archived"`
+	ArchivedAt            string                           `json:"archivedAt"`
+}
+
+// PropertyWithHistory represents a property with its historical values
+type PropertyWithHistory struct {
+	Value           string `json:"value"`
+	Timestamp       string `json:"timestamp"`
+	SourceType      string `json:"sourceType"`
+	SourceID        string `json:"sourceId"`
+	SourceLabel     string `json:"sourceLabel"`
+	UpdatedByUserID int    `json:"updatedByUserId"`
+}
+
+// AssociationSpec defines the type of an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
+}
+
+// AssociationTarget identifies the record an engagement is associated to
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// Association associates an engagement to an existing record at create time
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// CreateEngagementInput represents the input for creating an engagement
+type CreateEngagementInput struct {
+	Properties   map[string]string `json:"properties"`
+	Associations []Association     `json:"associations,omitempty"`
+}
+
+// NewNoteInput builds the input for creating a note with hs_note_body and hs_timestamp set
+func NewNoteInput(body string, timestamp time.Time, associations ...Association) *CreateEngagementInput {
+	return &CreateEngagementInput{
+		Properties: map[string]string{
+			PropertyNoteBody:  body,
+			PropertyTimestamp: FormatTimestamp(timestamp),
+		},
+		Associations: associations,
+	}
+}
+
+// FormatTimestamp formats t the way HubSpot expects for hs_timestamp and other datetime properties
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// UpdateEngagementInput represents the input for updating an engagement
+type UpdateEngagementInput struct {
+	Properties map[string]string `json:"properties"`
+}
+
+// ListEngagementsResponse represents the response from listing engagements
+type ListEngagementsResponse struct {
+	Results []Engagement `json:"results"`
+	Paging  *Paging      `json:"paging"`
+}
+
+// Paging represents pagination information
+type Paging struct {
+	Next *PagingLink `json:"next"`
+	Prev *PagingLink `json:"prev"`
+}
+
+// PagingLink represents a pagination link
+type PagingLink struct {
+	After string `json:"after"`
+	Link  string `json:"link"`
+}
+
+// BatchReadEngagementsInput represents input for batch read
+type BatchReadEngagementsInput struct {
+	Properties            []string `json:"properties"`
+	PropertiesWithHistory []string `json:"propertiesWithHistory"`
+	Inputs                []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchCreateEngagementsInput represents input for batch create
+type BatchCreateEngagementsInput struct {
+	Inputs []CreateEngagementInput `json:"inputs"`
+}
+
+// BatchUpdateEngagementsInput represents input for batch update
+type BatchUpdateEngagementsInput struct {
+	Inputs []struct {
+		ID         string            `json:"id"`
+		Properties map[string]string `json:"properties"`
+	} `json:"inputs"`
+}
+
+// BatchArchiveEngagementsInput represents input for batch archive
+type BatchArchiveEngagementsInput struct {
+	Inputs []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchEngagementsResponse represents response from batch operations
+type BatchEngagementsResponse struct {
+	Status      string       `json:"status"`
+	Results     []Engagement `json:"results"`
+	StartedAt   string       `json:"startedAt"`
+	CompletedAt string       `json:"completedAt"`
+}
@@ -0,0 +1,53 @@
+package engagements
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// EngagementOption represents a functional option for engagement requests
+type EngagementOption func(*client.Request)
+
+// WithProperties specifies which properties to return; repeated calls add to the list
+func WithProperties(properties []string) EngagementOption {
+	return func(req *client.Request) {
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
+	}
+}
+
+// WithPropertiesWithHistory specifies which properties to return with history
+func WithPropertiesWithHistory(properties []string) EngagementOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("propertiesWithHistory", strings.Join(properties, ","))
+	}
+}
+
+// WithAssociations specifies which associations to return; repeated calls add to the list
+func WithAssociations(associations []string) EngagementOption {
+	return func(req *client.Request) {
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
+	}
+}
+
+// WithLimit sets the maximum number of results per page
+func WithLimit(limit int) EngagementOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("limit", fmt.Sprintf("%d", limit))
+	}
+}
+
+// WithAfter sets the paging cursor
+func WithAfter(after string) EngagementOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("after", after)
+	}
+}
+
+// WithArchived includes archived engagements
+func WithArchived() EngagementOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("archived", "true")
+	}
+}
@@ -0,0 +1,23 @@
+package lineitems
+
+import "context"
+
+// API is the set of line items client methods, implemented by *Client
+//
+// Code that calls the line items API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateLineItem(ctx context.Context, input *CreateLineItemInput) (*LineItem, error)
+	CreateLineItemForDeal(ctx context.Context, dealID string, input *CreateLineItemInput) (*LineItem, error)
+	GetLineItem(ctx context.Context, lineItemID string, opts ...LineItemOption) (*LineItem, error)
+	UpdateLineItem(ctx context.Context, lineItemID string, input *UpdateLineItemInput) (*LineItem, error)
+	ArchiveLineItem(ctx context.Context, lineItemID string) error
+	ListLineItems(ctx context.Context, opts ...LineItemOption) (*ListLineItemsResponse, error)
+	BatchReadLineItems(ctx context.Context, input *BatchReadLineItemsInput) (*BatchLineItemsResponse, error)
+	BatchCreateLineItems(ctx context.Context, input *BatchCreateLineItemsInput) (*BatchLineItemsResponse, error)
+	BatchUpdateLineItems(ctx context.Context, input *BatchUpdateLineItemsInput) (*BatchLineItemsResponse, error)
+	BatchArchiveLineItems(ctx context.Context, input *BatchArchiveLineItemsInput) error
+	SearchLineItems(ctx context.Context, input *SearchLineItemsInput) (*SearchLineItemsResponse, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,249 @@
+// Package lineitems provides client methods for the HubSpot CRM Line Items API
+package lineitems
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
+)
+
+// Client represents the Line Items API client
+type Client struct {
+	apiClient *client.Client
+}
+
+// NewClient creates a new line items client
+func NewClient(apiClient *client.Client) *Client {
+	return &Client{
+		apiClient: apiClient,
+	}
+}
+
+// CreateLineItem creates a new line item
+func (c *Client) CreateLineItem(ctx context.Context, input *CreateLineItemInput) (*LineItem, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.LineItems, input.Properties)
+
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var lineItem LineItem
+	if err := json.Unmarshal(resp.Body, &lineItem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal line item response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &lineItem, nil
+}
+
+// CreateLineItemForDeal creates a line item already associated to the deal dealID
+//
+// Line items belong to a single deal, so the association is made in the create request rather than as a separate call.
+// Any associations already in input are kept. input is not modified
+func (c *Client) CreateLineItemForDeal(ctx context.Context, dealID string, input *CreateLineItemInput) (*LineItem, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDeal := *input
+	withDeal.Associations = append(slices.Clone(input.Associations), Association{
+		To: AssociationTarget{ID: dealID},
+		Types: []AssociationSpec{{
+			AssociationCategory: AssociationCategoryHubSpotDefined,
+			AssociationTypeID:   AssociationTypeLineItemToDeal,
+		}},
+	})
+
+	return c.CreateLineItem(ctx, &withDeal)
+}
+
+// GetLineItem retrieves a line item by ID
+func (c *Client) GetLineItem(ctx context.Context, lineItemID string, opts ...LineItemOption) (*LineItem, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/line_items/%s", lineItemID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, client.ParseNotFoundError(err, objecttypes.LineItems, lineItemID)
+	}
+
+	var lineItem LineItem
+	if err := json.Unmarshal(resp.Body, &lineItem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal line item response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &lineItem, nil
+}
+
+// UpdateLineItem updates a line item
+func (c *Client) UpdateLineItem(ctx context.Context, lineItemID string, input *UpdateLineItemInput) (*LineItem, error) {
+	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/line_items/%s", lineItemID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var lineItem LineItem
+	if err := json.Unmarshal(resp.Body, &lineItem); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal line item response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &lineItem, nil
+}
+
+// ArchiveLineItem archives (deletes) a line item
+func (c *Client) ArchiveLineItem(ctx context.Context, lineItemID string) error {
+	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/line_items/%s", lineItemID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
+
+// ListLineItems lists line items with optional filters
+func (c *Client) ListLineItems(ctx context.Context, opts ...LineItemOption) (*ListLineItemsResponse, error) {
+	req := client.NewRequest("GET", "/crm/v3/objects/line_items")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithPaging()
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var listResp ListLineItemsResponse
+	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal line items list response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &listResp, nil
+}
+
+// BatchReadLineItems retrieves multiple line items by ID
+func (c *Client) BatchReadLineItems(ctx context.Context, input *BatchReadLineItemsInput) (*BatchLineItemsResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items/batch/read")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchLineItemsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchCreateLineItems creates multiple line items
+func (c *Client) BatchCreateLineItems(ctx context.Context, input *BatchCreateLineItemsInput) (*BatchLineItemsResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.LineItems, withDefaults.Inputs[i].Properties)
+	}
+
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items/batch/create")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchLineItemsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchUpdateLineItems updates multiple line items
+func (c *Client) BatchUpdateLineItems(ctx context.Context, input *BatchUpdateLineItemsInput) (*BatchLineItemsResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items/batch/update")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchLineItemsResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchArchiveLineItems archives multiple line items
+func (c *Client) BatchArchiveLineItems(ctx context.Context, input *BatchArchiveLineItemsInput) error {
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items/batch/archive")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithBody(input)
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
+
+// SearchLineItems searches for line items
+func (c *Client) SearchLineItems(ctx context.Context, input *SearchLineItemsInput) (*SearchLineItemsResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/line_items/search")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.LineItems)
+	req.WithPaging()
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp SearchLineItemsResponse
+	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &searchResp, nil
+}
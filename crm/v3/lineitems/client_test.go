@@ -0,0 +1,227 @@
+package lineitems
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper functions
+func setupMockServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(handler))
+
+	apiClient, err := client.NewClient(
+		client.WithBaseURL(server.URL),
+		client.WithAccessToken("test-token"),
+		client.WithRateLimitEnabled(false),
+		client.WithRetryEnabled(false),
+	)
+	require.NoError(t, err)
+
+	lineItemsClient := NewClient(apiClient)
+	return server, lineItemsClient
+}
+
+func respondJSON(w http.ResponseWriter, statusCode int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(body))
+}
+
+const lineItemJSON = `{
+	"id": "789",
+	"properties": {
+		"name": "Widget",
+		"hs_product_id": "456",
+		"quantity": "3",
+		"price": "12.5",
+		"amount": "37.50"
+	},
+	"createdAt": "2024-01-01T00:00:00.000Z",
+	"updatedAt": "2024-01-01T00:00:00.000Z",
+	"archived": false
+}`
+
+// TestNewClient tests client creation
+func TestNewClient(t *testing.T) {
+	apiClient, err := client.NewClient()
+	require.NoError(t, err)
+
+	lineItemsClient := NewClient(apiClient)
+	assert.NotNil(t, lineItemsClient)
+	assert.NotNil(t, lineItemsClient.apiClient)
+}
+
+// TestCreateLineItem_Success tests line item creation
+func TestCreateLineItem_Success(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/line_items", r.URL.Path)
+		respondJSON(w, http.StatusCreated, lineItemJSON)
+	})
+	defer server.Close()
+
+	input := &CreateLineItemInput{
+		Properties: LineItemFields{ProductID: "456", Quantity: 3}.Properties(nil),
+	}
+
+	lineItem, err := lineItemsClient.CreateLineItem(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "789", lineItem.ID)
+	assert.Equal(t, "456", lineItem.ProductID())
+	assert.Equal(t, 3.0, lineItem.Quantity())
+	assert.Equal(t, 12.5, lineItem.Price())
+	assert.Equal(t, 37.5, lineItem.Amount())
+}
+
+// TestCreateLineItemForDeal_Success tests that the deal association is sent with the create request
+func TestCreateLineItemForDeal_Success(t *testing.T) {
+	var body CreateLineItemInput
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/line_items", r.URL.Path)
+		raw, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(raw, &body))
+		respondJSON(w, http.StatusCreated, lineItemJSON)
+	})
+	defer server.Close()
+
+	input := &CreateLineItemInput{
+		Properties: map[string]string{PropertyProductID: "456"},
+	}
+
+	lineItem, err := lineItemsClient.CreateLineItemForDeal(context.Background(), "123", input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "789", lineItem.ID)
+	require.Len(t, body.Associations, 1)
+	assert.Equal(t, "123", body.Associations[0].To.ID)
+	assert.Equal(t, []AssociationSpec{{
+		AssociationCategory: AssociationCategoryHubSpotDefined,
+		AssociationTypeID:   AssociationTypeLineItemToDeal,
+	}}, body.Associations[0].Types)
+	assert.Empty(t, input.Associations)
+}
+
+// TestCreateLineItemForDeal_NilInput tests that a nil input returns an error instead of panicking
+func TestCreateLineItemForDeal_NilInput(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	defer server.Close()
+
+	lineItem, err := lineItemsClient.CreateLineItemForDeal(context.Background(), "123", nil)
+
+	require.Error(t, err)
+	assert.Nil(t, lineItem)
+}
+
+// TestGetLineItem_WithOptions tests retrieving a line item with properties
+func TestGetLineItem_WithOptions(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/crm/v3/objects/line_items/789", r.URL.Path)
+		assert.Equal(t, "quantity,price", r.URL.Query().Get("properties"))
+		respondJSON(w, http.StatusOK, lineItemJSON)
+	})
+	defer server.Close()
+
+	lineItem, err := lineItemsClient.GetLineItem(context.Background(), "789",
+		WithProperties([]string{PropertyQuantity, PropertyPrice}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "789", lineItem.ID)
+}
+
+// TestArchiveLineItem_Success tests archiving a line item
+func TestArchiveLineItem_Success(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/crm/v3/objects/line_items/789", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	err := lineItemsClient.ArchiveLineItem(context.Background(), "789")
+
+	require.NoError(t, err)
+}
+
+// TestBatchCreateLineItems_Success tests batch creation
+func TestBatchCreateLineItems_Success(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/line_items/batch/create", r.URL.Path)
+		respondJSON(w, http.StatusCreated, `{"status": "COMPLETE", "results": [`+lineItemJSON+`]}`)
+	})
+	defer server.Close()
+
+	input := &BatchCreateLineItemsInput{
+		Inputs: []CreateLineItemInput{{Properties: map[string]string{PropertyName: "Widget"}}},
+	}
+
+	resp, err := lineItemsClient.BatchCreateLineItems(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETE", resp.Status)
+	assert.Len(t, resp.Results, 1)
+}
+
+// TestSearchLineItems_Success tests searching line items
+func TestSearchLineItems_Success(t *testing.T) {
+	server, lineItemsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/line_items/search", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"total": 1, "results": [`+lineItemJSON+`]}`)
+	})
+	defer server.Close()
+
+	input := &SearchLineItemsInput{
+		FilterGroups: []FilterGroup{{
+			Filters: []Filter{{PropertyName: PropertyProductID, Operator: "EQ", Value: "456"}},
+		}},
+	}
+
+	resp, err := lineItemsClient.SearchLineItems(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+	assert.Equal(t, "456", resp.Results[0].ProductID())
+}
+
+// TestLineItemFields_Properties tests building properties from typed fields
+func TestLineItemFields_Properties(t *testing.T) {
+	extra := map[string]string{"description": "Blue", PropertyPrice: "1"}
+
+	properties := LineItemFields{Name: "Widget", Quantity: 2, Price: 9.99}.Properties(extra)
+
+	assert.Equal(t, map[string]string{
+		"description":    "Blue",
+		PropertyName:     "Widget",
+		PropertyQuantity: "2",
+		PropertyPrice:    "9.99",
+	}, properties)
+	assert.Equal(t, "1", extra[PropertyPrice])
+}
+
+// TestLineItem_MissingNumber tests accessors on unset or malformed properties
+func TestLineItem_MissingNumber(t *testing.T) {
+	lineItem := LineItem{Properties: map[string]string{PropertyPrice: "n/a"}}
+
+	assert.Equal(t, 0.0, lineItem.Price())
+	assert.Equal(t, 0.0, lineItem.Quantity())
+	assert.Equal(t, "", lineItem.ProductID())
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
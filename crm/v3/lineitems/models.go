@@ -0,0 +1,217 @@
+package lineitems
+
+import "strconv"
+
+// Line item properties with typed accessors on LineItem and typed fields on LineItemFields
+const (
+	PropertyName      = "name"
+	PropertyProductID = "hs_product_id"
+	PropertyQuantity  = "quantity"
+	PropertyPrice     = "price"
+	PropertyAmount    = "amount" // Calculated by HubSpot from quantity, price and discounts; read-only
+)
+
+// Association values used when creating a line item already associated to a deal
+const (
+	AssociationCategoryHubSpotDefined = "HUBSPOT_DEFINED"
+	AssociationTypeLineItemToDeal     = 20
+)
+
+// LineItem represents a HubSpot line item object
+type LineItem struct {
+	ID                    string                           `json:"id"`
+	Properties            map[string]string                `json:"properties"`
+	PropertiesWithHistory map[string][]PropertyWithHistory `json:"propertiesWithHistory"`
+	CreatedAt             string                           `json:"createdAt"`
+	UpdatedAt             string                           `json:"updatedAt"`
+	Archived              bool                             `json:"archived"`
+	ArchivedAt            string                           `json:"archivedAt"`
+}
+
+// ProductID returns the ID of the product the line item was created from, or "" for a custom line item
+func (li *LineItem) ProductID() string {
+	return li.Properties[PropertyProductID]
+}
+
+// Quantity returns the line item's quantity, or 0 if it is unset or was not requested
+func (li *LineItem) Quantity() float64 {
+	return li.number(PropertyQuantity)
+}
+
+// Price returns the line item's unit price, or 0 if it is unset or was not requested
+func (li *LineItem) Price() float64 {
+	return li.number(PropertyPrice)
+}
+
+// Amount returns the line item's total after discounts, or 0 if it is unset or was not requested
+func (li *LineItem) Amount() float64 {
+	return li.number(PropertyAmount)
+}
+
+// number parses the numeric property name, returning 0 when it is missing or not a number
+func (li *LineItem) number(name string) float64 {
+	value, err := strconv.ParseFloat(li.Properties[name], 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// LineItemFields holds the commonly set line item properties as typed values
+//
+// Zero values are left out, so a line item created from a product can set only ProductID and Quantity and inherit
+// the product's name and price
+type LineItemFields struct {
+	Name      string
+	ProductID string
+	Quantity  float64
+	Price     float64
+}
+
+// Properties returns the fields as a properties map, merged over extra
+//
+// The typed fields win over entries of extra with the same name. extra is not modified
+func (f LineItemFields) Properties(extra map[string]string) map[string]string {
+	properties := make(map[string]string, len(extra)+4)
+	for k, v := range extra {
+		properties[k] = v
+	}
+
+	if f.Name != "" {
+		properties[PropertyName] = f.Name
+	}
+	if f.ProductID != "" {
+		properties[PropertyProductID] = f.ProductID
+	}
+	if f.Quantity != 0 {
+		properties[PropertyQuantity] = strconv.FormatFloat(f.Quantity, 'f', -1, 64)
+	}
+	if f.Price != 0 {
+		properties[PropertyPrice] = strconv.FormatFloat(f.Price, 'f', -1, 64)
+	}
+
+	return properties
+}
+
+// PropertyWithHistory represents a property with its historical values
+type PropertyWithHistory struct {
+	Value           string `json:"value"`
+	Timestamp       string `json:"timestamp"`
+	SourceType      string `json:"sourceType"`
+	SourceID        string `json:"sourceId"`
+	SourceLabel     string `json:"sourceLabel"`
+	UpdatedByUserID int    `json:"updatedByUserId"`
+}
+
+// CreateLineItemInput represents the input for creating a line item
+type CreateLineItemInput struct {
+	Properties   map[string]string `json:"properties"`
+	Associations []Association     `json:"associations,omitempty"`
+}
+
+// Association associates a new line item to an existing record in the create request
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// AssociationTarget identifies the record to associate to
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationSpec defines the type of an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
+}
+
+// UpdateLineItemInput represents the input for updating a line item
+type UpdateLineItemInput struct {
+	Properties map[string]string `json:"properties"`
+}
+
+// ListLineItemsResponse represents the response from listing line items
+type ListLineItemsResponse struct {
+	Results []LineItem `json:"results"`
+	Paging  *Paging    `json:"paging"`
+}
+
+// Paging represents pagination information
+type Paging struct {
+	Next *PagingLink `json:"next"`
+	Prev *PagingLink `json:"prev"`
+}
+
+// PagingLink represents a pagination link
+type PagingLink struct {
+	After string `json:"after"`
+	Link  string `json:"link"`
+}
+
+// BatchReadLineItemsInput represents input for batch read
+type BatchReadLineItemsInput struct {
+	Properties            []string `json:"properties"`
+	PropertiesWithHistory []string `json:"propertiesWithHistory"`
+	IDProperty            string   `json:"idProperty"`
+	Inputs                []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchCreateLineItemsInput represents input for batch create
+type BatchCreateLineItemsInput struct {
+	Inputs []CreateLineItemInput `json:"inputs"`
+}
+
+// BatchUpdateLineItemsInput represents input for batch update
+type BatchUpdateLineItemsInput struct {
+	Inputs []struct {
+		ID         string            `json:"id"`
+		Properties map[string]string `json:"properties"`
+	} `json:"inputs"`
+}
+
+// BatchArchiveLineItemsInput represents input for batch archive
+type BatchArchiveLineItemsInput struct {
+	Inputs []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchLineItemsResponse represents response from batch operations
+type BatchLineItemsResponse struct {
+	Status      string     `json:"status"`
+	Results     []LineItem `json:"results"`
+	StartedAt   string     `json:"startedAt"`
+	CompletedAt string     `json:"completedAt"`
+}
+
+// SearchLineItemsInput represents input for searching line items
+type SearchLineItemsInput struct {
+	FilterGroups []FilterGroup `json:"filterGroups"`
+	Sorts        []string      `json:"sorts"`
+	Query        string        `json:"query"`
+	Properties   []string      `json:"properties"`
+	Limit        int           `json:"limit"`
+	After        string        `json:"after"`
+}
+
+// FilterGroup represents a group of filters
+type FilterGroup struct {
+	Filters []Filter `json:"filters"`
+}
+
+// Filter represents a single filter
+type Filter struct {
+	PropertyName string `json:"propertyName"`
+	Operator     string `json:"operator"`
+	Value        any    `json:"value"`
+}
+
+// SearchLineItemsResponse represents response from search
+type SearchLineItemsResponse struct {
+	Total   int        `json:"total"`
+	Results []LineItem `json:"results"`
+	Paging  *Paging    `json:"paging"`
+}
@@ -0,0 +1,37 @@
+package lists
+
+import "context"
+
+// API is the set of lists client methods, implemented by *Client
+//
+// Code that calls the lists API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	GetListByID(ctx context.Context, listID string, opts ...GetListOption) (*List, error)
+	GetListByName(ctx context.Context, ObjectTypeID, listName string, opts ...GetListOption) (*List, error)
+	CreateList(ctx context.Context, input *ListCreateRequest) (*List, error)
+	GetListsByIDs(ctx context.Context, listIDs []string, opts ...GetListOption) ([]List, error)
+	SearchLists(ctx context.Context, input *ListSearchRequest) (*ListSearchResponse, error)
+	StreamLists(ctx context.Context, input *ListSearchRequest) (<-chan List, <-chan error)
+	UpdateListName(ctx context.Context, listID, listName string, includeFilters bool) (*List, error)
+	UpdateListFilters(ctx context.Context, listID string, filterBranch FilterBranch, includeFilters bool) (*List, error)
+	DeleteList(ctx context.Context, listID string) error
+	RestoreList(ctx context.Context, listID string) error
+	GetRecordMemberships(ctx context.Context, objectTypeID, recordID string, opts ...RecordMembershipsOption) (*RecordMembershipsResponse, error)
+	GetAllRecordMemberships(ctx context.Context, objectTypeID, recordID string) ([]RecordListMembership, error)
+	BatchGetRecordMemberships(ctx context.Context, inputs []MembershipRecordIdentifier) (*BatchReadMembershipsResponse, error)
+	AddRecordsToList(ctx context.Context, listID string, recordIDs []string) (*MembershipChangeResponse, error)
+	AddFromSourceList(ctx context.Context, listID, sourceListID string) (*MembershipChangeResponse, error)
+	AddFromSourceListFiltered(ctx context.Context, listID, sourceListID string, recordIDs []string) (*MembershipChangeResponse, error)
+	GetListMemberships(ctx context.Context, listID string, opts ...ListMembershipsOption) (*ListMembershipsResponse, error)
+	GetListMembersWithProperties(ctx context.Context, listID string, properties []string, opts ...ListMembershipsOption) (*ListMembersResponse, error)
+	RemoveAllRecords(ctx context.Context, listID string) error
+	RemoveRecordsFromList(ctx context.Context, listID string, recordIDs []string) (*MembershipChangeResponse, error)
+	AddRecordIdentifiersToList(ctx context.Context, listID string, records []MembershipRecordIdentifier) (*MembershipChangeResponse, error)
+	RemoveRecordIdentifiersFromList(ctx context.Context, listID string, records []MembershipRecordIdentifier) (*MembershipChangeResponse, error)
+	ScheduleConversion(ctx context.Context, listID string, conversionReq *ScheduleConversionRequest) (*ScheduleConversionResponse, error)
+	GetConversionSchedule(ctx context.Context, listID string) (*ScheduleConversionResponse, error)
+	DeleteConversionSchedule(ctx context.Context, listID string) error
+}
+
+var _ API = (*Client)(nil)
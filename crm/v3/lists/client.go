@@ -5,8 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 type Client struct {
@@ -20,10 +25,15 @@ func NewClient(apiClient *client.Client) *Client {
 	}
 }
 
+// GetListByID returns the list with listID
+//
+// Filters are included by default; pass WithIncludeFilters(false) to leave List.FilterBranch out. A list that has been
+// deleted but is still restorable returns a *ListDeletedError rather than the list
 func (c *Client) GetListByID(ctx context.Context, listID string, opts ...GetListOption) (*List, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/lists/%s", listID))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
+	req.AddQueryParam("includeFilters", "true")
 
 	// Apply options
 	for _, opt := range opts {
@@ -37,16 +47,25 @@ func (c *Client) GetListByID(ctx context.Context, listID string, opts ...GetList
 
 	var list ListResponse
 	if err := json.Unmarshal(resp.Body, &list); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list response: %w", tools.DecodeError(err, resp))
+	}
+
+	if list.List.DeletedAt != nil {
+		return nil, &ListDeletedError{ListID: listID, DeletedAt: *list.List.DeletedAt}
 	}
 
 	return &list.List, nil
 }
 
+// GetListByName returns the list of ObjectTypeID named listName
+//
+// Filters are included by default; pass WithIncludeFilters(false) to leave List.FilterBranch out
 func (c *Client) GetListByName(ctx context.Context, ObjectTypeID, listName string, opts ...GetListOption) (*List, error) {
-	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/lists/object-type-id/%s/name/%s", ObjectTypeID, listName))
+	// List names are free text, so escape them rather than letting spaces or slashes change the route
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/lists/object-type-id/%s/name/%s", url.PathEscape(ObjectTypeID), url.PathEscape(listName)))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
+	req.AddQueryParam("includeFilters", "true")
 
 	// Apply options
 	for _, opt := range opts {
@@ -60,7 +79,7 @@ func (c *Client) GetListByName(ctx context.Context, ObjectTypeID, listName strin
 
 	var list ListResponse
 	if err := json.Unmarshal(resp.Body, &list); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &list.List, nil
@@ -79,38 +98,88 @@ func (c *Client) CreateList(ctx context.Context, input *ListCreateRequest) (*Lis
 
 	var listResp ListResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp.List, nil
 }
 
-func (c *Client) GetListsByIDs(ctx context.Context, listIDs []string, opts ...GetListOption) ([]List, error) {
-	req := client.NewRequest("GET", "/crm/v3/lists")
-	req.WithContext(ctx)
-	req.WithResourceType("lists")
+// maxListIDsQueryLength bounds the encoded listIds parameters of one GetListsByIDs request, keeping its URL well
+// under the length servers and proxies accept
+const maxListIDsQueryLength = 2000
 
-	// Add listIds as query parameters
-	for _, id := range listIDs {
-		req.AddQueryParam("listIds", id)
-	}
+// GetListsByIDs fetches the lists with the given IDs
+//
+// The IDs are sent as repeated listIds parameters, split over as many requests as needed to keep each URL short, and
+// the lists of every request are returned together. If some IDs weren't returned, e.g. because those lists don't
+// exist, the lists that were found are returned with a *ListsNotFoundError naming the missing IDs. A failed request
+// stops the fetch and returns its error, converted with ParseListError
+func (c *Client) GetListsByIDs(ctx context.Context, listIDs []string, opts ...GetListOption) ([]List, error) {
+	var lists []List
+	for _, chunk := range chunkListIDs(listIDs) {
+		req := client.NewRequest("GET", "/crm/v3/lists")
+		req.WithContext(ctx)
+		req.WithResourceType("lists")
+		req.AddRepeatedQueryParam("listIds", chunk...)
+
+		// Apply options
+		for _, opt := range opts {
+			opt(req)
+		}
+
+		resp, err := c.apiClient.Do(ctx, req)
+		if err != nil {
+			return nil, ParseListError(err, strings.Join(chunk, ","))
+		}
+
+		var listsResp ListsByIDResponse
+		if err := json.Unmarshal(resp.Body, &listsResp); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lists response: %w", tools.DecodeError(err, resp))
+		}
+		lists = append(lists, listsResp.Lists...)
+	}
+
+	if missing := missingListIDs(listIDs, lists); len(missing) > 0 {
+		return lists, &ListsNotFoundError{ListIDs: missing}
+	}
+	return lists, nil
+}
 
-	// Apply options
-	for _, opt := range opts {
-		opt(req)
-	}
+// chunkListIDs splits ids into groups whose encoded listIds parameters fit in maxListIDsQueryLength
+func chunkListIDs(ids []string) [][]string {
+	var chunks [][]string
+	var chunk []string
+	length := 0
+	for _, id := range ids {
+		paramLength := len("listIds=") + len(url.QueryEscape(id)) + 1
+		if len(chunk) > 0 && length+paramLength > maxListIDsQueryLength {
+			chunks = append(chunks, chunk)
+			chunk, length = nil, 0
+		}
+		chunk = append(chunk, id)
+		length += paramLength
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
 
-	resp, err := c.apiClient.Do(ctx, req)
-	if err != nil {
-		return nil, ParseListError(err, "")
+// missingListIDs returns the IDs of requested, without duplicates, that no list in lists has
+func missingListIDs(requested []string, lists []List) []string {
+	seen := make(map[string]bool, len(lists))
+	for _, list := range lists {
+		seen[list.ListID] = true
 	}
 
-	var listsResp ListsByIDResponse
-	if err := json.Unmarshal(resp.Body, &listsResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal lists response: %w", err)
+	var missing []string
+	for _, id := range requested {
+		if !seen[id] {
+			seen[id] = true
+			missing = append(missing, id)
+		}
 	}
-
-	return listsResp.Lists, nil
+	return missing
 }
 
 func (c *Client) SearchLists(ctx context.Context, input *ListSearchRequest) (*ListSearchResponse, error) {
@@ -126,12 +195,57 @@ func (c *Client) SearchLists(ctx context.Context, input *ListSearchRequest) (*Li
 
 	var searchResp ListSearchResponse
 	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &searchResp, nil
 }
 
+// StreamLists pages through SearchLists and sends each list on the returned channel
+//
+// Both channels are closed when paging completes. A terminal error, including context cancellation, is sent on
+// the error channel before closing. input is not modified; its Offset is used as the starting point.
+func (c *Client) StreamLists(ctx context.Context, input *ListSearchRequest) (<-chan List, <-chan error) {
+	lists := make(chan List)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(lists)
+		defer close(errs)
+
+		page := ListSearchRequest{}
+		if input != nil {
+			page = *input
+		}
+
+		for {
+			resp, err := c.SearchLists(ctx, &page)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, list := range resp.Lists {
+				select {
+				case lists <- list:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			if !resp.HasMore || len(resp.Lists) == 0 {
+				return
+			}
+
+			offset := resp.Offset
+			page.Offset = &offset
+		}
+	}()
+
+	return lists, errs
+}
+
 func (c *Client) UpdateListName(ctx context.Context, listID, listName string, includeFilters bool) (*List, error) {
 	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/update-list-name", listID))
 	req.WithContext(ctx)
@@ -149,7 +263,7 @@ func (c *Client) UpdateListName(ctx context.Context, listID, listName string, in
 
 	var listResp ListResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp.List, nil
@@ -175,12 +289,16 @@ func (c *Client) UpdateListFilters(ctx context.Context, listID string, filterBra
 
 	var listResp ListResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp.List, nil
 }
 
+// DeleteList deletes the list with listID
+//
+// HubSpot only soft-deletes lists: a deleted list can be restored with RestoreList for 90 days, after which HubSpot
+// purges it. The API has no endpoint to permanently delete a list sooner
 func (c *Client) DeleteList(ctx context.Context, listID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/lists/%s", listID))
 	req.WithContext(ctx)
@@ -194,6 +312,7 @@ func (c *Client) DeleteList(ctx context.Context, listID string) error {
 	return nil
 }
 
+// RestoreList restores a list deleted with DeleteList within the last 90 days
 func (c *Client) RestoreList(ctx context.Context, listID string) error {
 	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/restore", listID))
 	req.WithContext(ctx)
@@ -207,11 +326,23 @@ func (c *Client) RestoreList(ctx context.Context, listID string) error {
 	return nil
 }
 
-func (c *Client) GetRecordMemberships(ctx context.Context, objectTypeID, recordID string) (*RecordMembershipsResponse, error) {
+// GetRecordMemberships returns a page of the lists that the record recordID of objectTypeID is a member of
+//
+// When the response's NextAfter is not empty more memberships remain; pass it to WithRecordMembershipsAfter to read
+// the next page, or use GetAllRecordMemberships. Returns *RecordNotFoundError if the record doesn't exist.
+//
+// opts:
+// WithRecordMembershipsLimit
+// WithRecordMembershipsAfter
+func (c *Client) GetRecordMemberships(ctx context.Context, objectTypeID, recordID string, opts ...RecordMembershipsOption) (*RecordMembershipsResponse, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/lists/records/%s/%s/memberships", objectTypeID, recordID))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
 
+	for _, opt := range opts {
+		opt(req)
+	}
+
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
 		return nil, ParseRecordError(err, recordID, "")
@@ -219,12 +350,36 @@ func (c *Client) GetRecordMemberships(ctx context.Context, objectTypeID, recordI
 
 	var memberships RecordMembershipsResponse
 	if err := json.Unmarshal(resp.Body, &memberships); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal memberships response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal memberships response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &memberships, nil
 }
 
+// GetAllRecordMemberships pages through GetRecordMemberships and returns every list the record is a member of
+func (c *Client) GetAllRecordMemberships(ctx context.Context, objectTypeID, recordID string) ([]RecordListMembership, error) {
+	var memberships []RecordListMembership
+	var after string
+	for {
+		var opts []RecordMembershipsOption
+		if after != "" {
+			opts = append(opts, WithRecordMembershipsAfter(after))
+		}
+
+		page, err := c.GetRecordMemberships(ctx, objectTypeID, recordID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, page.Results...)
+
+		next := page.NextAfter()
+		if next == "" || next == after {
+			return memberships, nil
+		}
+		after = next
+	}
+}
+
 func (c *Client) BatchGetRecordMemberships(ctx context.Context, inputs []MembershipRecordIdentifier) (*BatchReadMembershipsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/lists/records/memberships/batch/read")
 	req.WithContext(ctx)
@@ -238,14 +393,63 @@ func (c *Client) BatchGetRecordMemberships(ctx context.Context, inputs []Members
 
 	var batchResp BatchReadMembershipsResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch memberships response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch memberships response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
 }
 
+// AddRecordsToList adds records to a manual or snapshot list
+//
+// The records are taken to be of the list's object type, so this works the same for contact, company and custom-object
+// lists. Use AddRecordIdentifiersToList to have the object type of each record checked against the list first.
+//
+// Adding a record that is already a member is not an error. The response reports newly added records in
+// RecordIDsAdded, records that don't exist in RecordIDsMissing and records that were already members in
+// RecordIDsAlreadyPresent, so a sync job can tell when an add was a no-op.
+//
+// Records are sent in batches of MembershipBatchSize, one after another, and the responses are combined. Adding is
+// stopped when ctx is done or a batch fails; the error names the records of the failed batch, and the combined
+// response of the batches already added is returned with it, or nil if the first batch failed. No request is made
+// when recordIDs is empty.
 func (c *Client) AddRecordsToList(ctx context.Context, listID string, recordIDs []string) (*MembershipChangeResponse, error) {
-	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/memberships/add", listID))
+	changeResp, sent, err := c.changeMemberships(ctx, listID, "add", recordIDs)
+	if changeResp != nil {
+		changeResp.RecordIDsAlreadyPresent = alreadyPresent(recordIDs[:sent], changeResp.RecordIDsAdded, changeResp.RecordIDsMissing)
+	}
+	return changeResp, err
+}
+
+// changeMemberships sends recordIDs to the memberships/add or memberships/remove endpoint of a list in batches of
+// MembershipBatchSize, combining the responses
+//
+// sent is the number of records in the batches that succeeded. No request is made for an empty recordIDs
+func (c *Client) changeMemberships(ctx context.Context, listID, change string, recordIDs []string) (combined *MembershipChangeResponse, sent int, err error) {
+	combined = &MembershipChangeResponse{}
+	for batch := range slices.Chunk(recordIDs, MembershipBatchSize) {
+		changeResp, err := c.changeMembershipBatch(ctx, listID, change, batch)
+		if err != nil {
+			if sent == 0 {
+				return nil, 0, err
+			}
+			return combined, sent, fmt.Errorf("records %d-%d: %w", sent, sent+len(batch)-1, err)
+		}
+
+		combined.RecordIDsAdded = append(combined.RecordIDsAdded, changeResp.RecordIDsAdded...)
+		combined.RecordIDsRemoved = append(combined.RecordIDsRemoved, changeResp.RecordIDsRemoved...)
+		combined.RecordIDsMissing = append(combined.RecordIDsMissing, changeResp.RecordIDsMissing...)
+		sent += len(batch)
+	}
+	return combined, sent, nil
+}
+
+// changeMembershipBatch sends a single batch of records to the memberships/add or memberships/remove endpoint
+func (c *Client) changeMembershipBatch(ctx context.Context, listID, change string, recordIDs []string) (*MembershipChangeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/memberships/%s", listID, change))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
 	req.WithBody(recordIDs)
@@ -257,12 +461,36 @@ func (c *Client) AddRecordsToList(ctx context.Context, listID string, recordIDs
 
 	var changeResp MembershipChangeResponse
 	if err := json.Unmarshal(resp.Body, &changeResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal membership change response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal membership change response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &changeResp, nil
 }
 
+// alreadyPresent returns the records of requested, without duplicates, that are in neither added nor missing
+func alreadyPresent(requested, added, missing []string) []string {
+	seen := make(map[string]bool, len(added)+len(missing))
+	for _, id := range added {
+		seen[id] = true
+	}
+	for _, id := range missing {
+		seen[id] = true
+	}
+
+	var present []string
+	for _, id := range requested {
+		if !seen[id] {
+			seen[id] = true
+			present = append(present, id)
+		}
+	}
+	return present
+}
+
+// AddFromSourceList copies every member of the source list into the list
+//
+// HubSpot copies the whole source list; use AddFromSourceListFiltered to copy only some of its members.
+// The response's RecordIDsAdded reports the records that were copied, so len(RecordIDsAdded) is the copy size.
 func (c *Client) AddFromSourceList(ctx context.Context, listID, sourceListID string) (*MembershipChangeResponse, error) {
 	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/memberships/add-from/%s", listID, sourceListID))
 	req.WithContext(ctx)
@@ -275,16 +503,55 @@ func (c *Client) AddFromSourceList(ctx context.Context, listID, sourceListID str
 
 	var changeResp MembershipChangeResponse
 	if err := json.Unmarshal(resp.Body, &changeResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal membership change response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal membership change response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &changeResp, nil
 }
 
+// AddFromSourceListFiltered copies the members of the source list that are also in recordIDs into the list
+//
+// The source list's memberships are paged through and intersected with recordIDs, and the matching records are
+// added with AddRecordsToList. Records in recordIDs that aren't members of the source list are not added.
+// If no records match, no add request is made and an empty response is returned.
+func (c *Client) AddFromSourceListFiltered(ctx context.Context, listID, sourceListID string, recordIDs []string) (*MembershipChangeResponse, error) {
+	wanted := make(map[string]bool, len(recordIDs))
+	for _, id := range recordIDs {
+		wanted[id] = true
+	}
+
+	var matched []string
+	var opts []ListMembershipsOption
+	for {
+		page, err := c.GetListMemberships(ctx, sourceListID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range page.Results {
+			if wanted[id] {
+				// Only add each record once, even if a page repeats it
+				delete(wanted, id)
+				matched = append(matched, id)
+			}
+		}
+		if page.HasMore == nil || !*page.HasMore || page.Offset == nil || *page.Offset == "" {
+			break
+		}
+		opts = []ListMembershipsOption{WithMembershipsOffset(*page.Offset)}
+	}
+
+	if len(matched) == 0 {
+		return &MembershipChangeResponse{}, nil
+	}
+
+	return c.AddRecordsToList(ctx, listID, matched)
+}
+
 func (c *Client) GetListMemberships(ctx context.Context, listID string, opts ...ListMembershipsOption) (*ListMembershipsResponse, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/lists/%s/memberships", listID))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
+	req.WithPaging()
 
 	// Apply options
 	for _, opt := range opts {
@@ -298,12 +565,54 @@ func (c *Client) GetListMemberships(ctx context.Context, listID string, opts ...
 
 	var memberships ListMembershipsResponse
 	if err := json.Unmarshal(resp.Body, &memberships); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal list memberships response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal list memberships response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &memberships, nil
 }
 
+// GetListMembersWithProperties reads a page of list members and batch reads the requested properties for them
+//
+// The list's objectTypeId selects the objects endpoint used for the batch read, which is sent in batches of 100.
+// Results are returned in membership order, leaving out members that couldn't be read; use the returned Offset with
+// WithMembershipsOffset to read the next page.
+//
+// opts:
+// WithMembershipsLimit
+// WithMembershipsOffset
+func (c *Client) GetListMembersWithProperties(ctx context.Context, listID string, properties []string, opts ...ListMembershipsOption) (*ListMembersResponse, error) {
+	list, err := c.GetListByID(ctx, listID, WithIncludeFilters(false))
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := c.GetListMemberships(ctx, listID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	members := &ListMembersResponse{
+		Results: []objects.Object{},
+		HasMore: memberships.HasMore,
+		Offset:  memberships.Offset,
+	}
+	if len(memberships.Results) == 0 {
+		return members, nil
+	}
+
+	ordered, err := objects.NewClient(c.apiClient).BatchReadObjectsOrdered(ctx, list.ObjectTypeID, memberships.Results, properties)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range ordered {
+		if obj != nil {
+			members.Results = append(members.Results, *obj)
+		}
+	}
+
+	return members, nil
+}
+
 func (c *Client) RemoveAllRecords(ctx context.Context, listID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/lists/%s/memberships", listID))
 	req.WithContext(ctx)
@@ -317,26 +626,77 @@ func (c *Client) RemoveAllRecords(ctx context.Context, listID string) error {
 	return nil
 }
 
+// RemoveRecordsFromList removes records from a manual or snapshot list
+//
+// As with AddRecordsToList, the records are taken to be of the list's object type, and they are sent in batches of
+// MembershipBatchSize.
 func (c *Client) RemoveRecordsFromList(ctx context.Context, listID string, recordIDs []string) (*MembershipChangeResponse, error) {
-	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/memberships/remove", listID))
-	req.WithContext(ctx)
-	req.WithResourceType("lists")
-	req.WithBody(recordIDs)
+	changeResp, _, err := c.changeMemberships(ctx, listID, "remove", recordIDs)
+	return changeResp, err
+}
 
-	resp, err := c.apiClient.Do(ctx, req)
+// AddRecordIdentifiersToList adds records to a list after checking they are of the list's object type
+//
+// HubSpot's membership endpoints take bare record IDs and read the object type from the list, so a record ID of the
+// wrong type would otherwise be matched against the wrong object. Records with an empty ObjectTypeID are assumed to be
+// of the list's type. Returns *ObjectTypeMismatchError without changing the list if any record does not match.
+func (c *Client) AddRecordIdentifiersToList(ctx context.Context, listID string, records []MembershipRecordIdentifier) (*MembershipChangeResponse, error) {
+	recordIDs, err := c.recordIDsForList(ctx, listID, records)
 	if err != nil {
-		return nil, ParseListError(err, listID)
+		return nil, err
 	}
 
-	var changeResp MembershipChangeResponse
-	if err := json.Unmarshal(resp.Body, &changeResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal membership change response: %w", err)
+	return c.AddRecordsToList(ctx, listID, recordIDs)
+}
+
+// RemoveRecordIdentifiersFromList removes records from a list after checking they are of the list's object type
+//
+// See AddRecordIdentifiersToList for how object types are checked.
+func (c *Client) RemoveRecordIdentifiersFromList(ctx context.Context, listID string, records []MembershipRecordIdentifier) (*MembershipChangeResponse, error) {
+	recordIDs, err := c.recordIDsForList(ctx, listID, records)
+	if err != nil {
+		return nil, err
 	}
 
-	return &changeResp, nil
+	return c.RemoveRecordsFromList(ctx, listID, recordIDs)
 }
 
+// recordIDsForList returns the IDs of records, or an error if any of them is not of the object type of listID
+func (c *Client) recordIDsForList(ctx context.Context, listID string, records []MembershipRecordIdentifier) ([]string, error) {
+	list, err := c.GetListByID(ctx, listID, WithIncludeFilters(false))
+	if err != nil {
+		return nil, err
+	}
+
+	recordIDs := make([]string, len(records))
+	for i, record := range records {
+		if record.ObjectTypeID != "" && record.ObjectTypeID != list.ObjectTypeID {
+			return nil, &ObjectTypeMismatchError{
+				ListID:             listID,
+				ListObjectTypeID:   list.ObjectTypeID,
+				RecordID:           record.RecordID,
+				RecordObjectTypeID: record.ObjectTypeID,
+			}
+		}
+		recordIDs[i] = record.RecordID
+	}
+
+	return recordIDs, nil
+}
+
+// ScheduleConversion schedules a dynamic list to be converted to a static list
+//
+// The fields required depend on ConversionType:
+//   - CONVERSION_DATE converts the list on a date and requires Year, Month and Day
+//   - INACTIVITY converts the list after it has been inactive for a while and requires TimeUnit and Offset
+//
+// The request is checked with ScheduleConversionRequest.Validate first, and its *ListValidationError values are
+// returned without sending the request if a field is missing or invalid
 func (c *Client) ScheduleConversion(ctx context.Context, listID string, conversionReq *ScheduleConversionRequest) (*ScheduleConversionResponse, error) {
+	if err := conversionReq.Validate(); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("PUT", fmt.Sprintf("/crm/v3/lists/%s/schedule-conversion", listID))
 	req.WithContext(ctx)
 	req.WithResourceType("lists")
@@ -349,7 +709,7 @@ func (c *Client) ScheduleConversion(ctx context.Context, listID string, conversi
 
 	var conversionResp ScheduleConversionResponse
 	if err := json.Unmarshal(resp.Body, &conversionResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal conversion schedule response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal conversion schedule response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &conversionResp, nil
@@ -367,7 +727,7 @@ func (c *Client) GetConversionSchedule(ctx context.Context, listID string) (*Sch
 
 	var conversionResp ScheduleConversionResponse
 	if err := json.Unmarshal(resp.Body, &conversionResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal conversion schedule response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal conversion schedule response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &conversionResp, nil
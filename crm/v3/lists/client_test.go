@@ -3,12 +3,18 @@ package lists
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -81,6 +87,25 @@ func TestGetListByID_Success(t *testing.T) {
 	assert.Equal(t, Complete, list.ProcessingStatus)
 }
 
+// TestGetListByID_IncludeFiltersDefault tests that single-list reads include filters unless opted out
+func TestGetListByID_IncludeFiltersDefault(t *testing.T) {
+	var got []string
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Query().Get("includeFilters"))
+		respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "name": "Leads"}}`)
+	})
+	defer server.Close()
+
+	_, err := listClient.GetListByID(context.Background(), "123")
+	require.NoError(t, err)
+	_, err = listClient.GetListByName(context.Background(), "0-1", "Leads")
+	require.NoError(t, err)
+	_, err = listClient.GetListByID(context.Background(), "123", WithIncludeFilters(false))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"true", "true", "false"}, got)
+}
+
 // TestGetListByID_WithIncludeFilters tests GetListByID with includeFilters option
 func TestGetListByID_WithIncludeFilters(t *testing.T) {
 	listJSON := `{
@@ -193,6 +218,22 @@ func TestGetListByName_Success(t *testing.T) {
 	assert.Equal(t, "Contact List", list.Name)
 }
 
+// TestGetListByName_SpecialCharacters tests that names with spaces, ampersands and slashes are path escaped
+func TestGetListByName_SpecialCharacters(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/object-type-id/0-1/name/Leads%20&%20Prospects%2FEU", r.URL.EscapedPath())
+		assert.Equal(t, "/crm/v3/lists/object-type-id/0-1/name/Leads & Prospects/EU", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"list": {"listId": "789", "name": "Leads & Prospects/EU", "objectTypeId": "0-1"}}`)
+	})
+	defer server.Close()
+
+	list, err := listClient.GetListByName(context.Background(), "0-1", "Leads & Prospects/EU")
+
+	require.NoError(t, err)
+	assert.Equal(t, "789", list.ListID)
+	assert.Equal(t, "Leads & Prospects/EU", list.Name)
+}
+
 // TestCreateList_Success tests successful list creation
 func TestCreateList_Success(t *testing.T) {
 	responseJSON := `{
@@ -302,7 +343,7 @@ func TestGetListsByIDs_Success(t *testing.T) {
 	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
 		assert.Equal(t, "/crm/v3/lists", r.URL.Path)
-		// Query params will have multiple listIds
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["listIds"])
 		respondJSON(w, http.StatusOK, responseJSON)
 	})
 	defer server.Close()
@@ -315,6 +356,54 @@ func TestGetListsByIDs_Success(t *testing.T) {
 	assert.Equal(t, "2", lists[1].ListID)
 }
 
+// TestGetListsByIDs_Chunked tests that large ID sets are split over several short requests and merged
+func TestGetListsByIDs_Chunked(t *testing.T) {
+	listIDs := make([]string, 500)
+	for i := range listIDs {
+		listIDs[i] = strconv.Itoa(100000 + i)
+	}
+
+	var requested []string
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.LessOrEqual(t, len(r.URL.RawQuery), maxListIDsQueryLength)
+		ids := r.URL.Query()["listIds"]
+		requested = append(requested, ids...)
+
+		// List 100499 doesn't exist
+		var lists []string
+		for _, id := range ids {
+			if id != "100499" {
+				lists = append(lists, fmt.Sprintf(`{"listId": %q}`, id))
+			}
+		}
+		respondJSON(w, http.StatusOK, fmt.Sprintf(`{"lists": [%s]}`, strings.Join(lists, ",")))
+	})
+	defer server.Close()
+
+	lists, err := listClient.GetListsByIDs(context.Background(), listIDs)
+
+	assert.Equal(t, listIDs, requested)
+	assert.Len(t, lists, 499)
+	var notFoundErr *ListsNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, []string{"100499"}, notFoundErr.ListIDs)
+}
+
+// TestGetListsByIDs_NotFound tests that a 404 is converted with ParseListError
+func TestGetListsByIDs_NotFound(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "List not found"}`)
+	})
+	defer server.Close()
+
+	lists, err := listClient.GetListsByIDs(context.Background(), []string{"1", "2"})
+
+	assert.Nil(t, lists)
+	var notFoundErr *ListNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "1,2", notFoundErr.ListID)
+}
+
 // TestSearchLists_Success tests successful list search
 func TestSearchLists_Success(t *testing.T) {
 	responseJSON := `{
@@ -481,6 +570,40 @@ func TestDeleteList_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestDeleteList_RestoreRoundTrip tests that a deleted list reports ListDeletedError until it is restored
+func TestDeleteList_RestoreRoundTrip(t *testing.T) {
+	deletedAt := ""
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "DELETE" && r.URL.Path == "/crm/v3/lists/123":
+			deletedAt = `, "deletedAt": "2024-03-01T12:00:00Z"`
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "PUT" && r.URL.Path == "/crm/v3/lists/123/restore":
+			deletedAt = ""
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/crm/v3/lists/123":
+			respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "name": "Leads"`+deletedAt+`}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	require.NoError(t, listClient.DeleteList(context.Background(), "123"))
+
+	_, err := listClient.GetListByID(context.Background(), "123")
+	var deletedErr *ListDeletedError
+	require.ErrorAs(t, err, &deletedErr)
+	assert.Equal(t, "123", deletedErr.ListID)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), deletedErr.DeletedAt)
+
+	require.NoError(t, listClient.RestoreList(context.Background(), "123"))
+
+	list, err := listClient.GetListByID(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Equal(t, "Leads", list.Name)
+}
+
 // TestDeleteList_NotFound tests 404 error on delete
 func TestDeleteList_NotFound(t *testing.T) {
 	errorJSON := `{
@@ -546,6 +669,42 @@ func TestGetRecordMemberships_Success(t *testing.T) {
 	assert.Equal(t, "1", memberships.Results[0].ListID)
 }
 
+// TestGetAllRecordMemberships_Paging tests collecting memberships across pages
+func TestGetAllRecordMemberships_Paging(t *testing.T) {
+	var afters []string
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+		if after == "" {
+			respondJSON(w, http.StatusOK, `{"results": [{"listId": "1"}, {"listId": "2"}], "total": 3, "paging": {"next": {"after": "2"}}}`)
+			return
+		}
+		respondJSON(w, http.StatusOK, `{"results": [{"listId": "3"}], "total": 3}`)
+	})
+	defer server.Close()
+
+	memberships, err := listClient.GetAllRecordMemberships(context.Background(), "0-1", "contact-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "2"}, afters)
+	require.Len(t, memberships, 3)
+	assert.Equal(t, "3", memberships[2].ListID)
+}
+
+// TestGetRecordMemberships_NotFound tests that a missing record returns RecordNotFoundError
+func TestGetRecordMemberships_NotFound(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "Record not found", "category": "OBJECT_NOT_FOUND"}`)
+	})
+	defer server.Close()
+
+	_, err := listClient.GetAllRecordMemberships(context.Background(), "0-1", "contact-404")
+
+	var notFoundErr *RecordNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "contact-404", notFoundErr.RecordID)
+}
+
 // TestBatchGetRecordMemberships_Success tests batch retrieval of record memberships
 func TestBatchGetRecordMemberships_Success(t *testing.T) {
 	responseJSON := `{
@@ -585,7 +744,7 @@ func TestBatchGetRecordMemberships_Success(t *testing.T) {
 // TestAddRecordsToList_Success tests successfully adding records to a list
 func TestAddRecordsToList_Success(t *testing.T) {
 	responseJSON := `{
-		"recordIdsAdded": ["contact-1", "contact-2"]
+		"recordsIdsAdded": ["contact-1", "contact-2"]
 	}`
 
 	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -608,10 +767,99 @@ func TestAddRecordsToList_Success(t *testing.T) {
 	assert.Len(t, result.RecordIDsAdded, 2)
 }
 
+// TestAddRecordsToList_AlreadyPresent tests telling newly added records from existing members and missing records
+func TestAddRecordsToList_AlreadyPresent(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"recordsIdsAdded": ["1"], "recordIdsMissing": ["4"]}`)
+	})
+	defer server.Close()
+
+	result, err := listClient.AddRecordsToList(context.Background(), "123", []string{"1", "2", "3", "2", "4"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, result.RecordIDsAdded)
+	assert.Equal(t, []string{"4"}, result.RecordIDsMissing)
+	assert.Equal(t, []string{"2", "3"}, result.RecordIDsAlreadyPresent)
+}
+
+// TestAddRecordsToList_Batches tests that large adds are split into batches and their responses combined
+func TestAddRecordsToList_Batches(t *testing.T) {
+	recordIDs := make([]string, 2500)
+	for i := range recordIDs {
+		recordIDs[i] = strconv.Itoa(i)
+	}
+
+	var sizes []int
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var batch []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		sizes = append(sizes, len(batch))
+
+		// The first record of each batch is already a member
+		added, _ := json.Marshal(batch[1:])
+		respondJSON(w, http.StatusOK, fmt.Sprintf(`{"recordsIdsAdded": %s}`, added))
+	})
+	defer server.Close()
+
+	result, err := listClient.AddRecordsToList(context.Background(), "123", recordIDs)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1000, 1000, 500}, sizes)
+	assert.Len(t, result.RecordIDsAdded, 2497)
+	assert.Equal(t, []string{"0", "1000", "2000"}, result.RecordIDsAlreadyPresent)
+}
+
+// TestAddRecordsToList_BatchFails tests that a failed batch stops the add and returns the batches already added
+func TestAddRecordsToList_BatchFails(t *testing.T) {
+	recordIDs := make([]string, 2500)
+	for i := range recordIDs {
+		recordIDs[i] = strconv.Itoa(i)
+	}
+
+	requests := 0
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 2 {
+			respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "Invalid input"}`)
+			return
+		}
+		var batch []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		added, _ := json.Marshal(batch)
+		respondJSON(w, http.StatusOK, fmt.Sprintf(`{"recordsIdsAdded": %s}`, added))
+	})
+	defer server.Close()
+
+	result, err := listClient.AddRecordsToList(context.Background(), "123", recordIDs)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "records 1000-1999")
+	assert.Equal(t, 2, requests)
+	require.NotNil(t, result)
+	assert.Len(t, result.RecordIDsAdded, 1000)
+	assert.Empty(t, result.RecordIDsAlreadyPresent)
+}
+
+// TestRemoveRecordsFromList_Canceled tests that no batch is sent once the context is done
+func TestRemoveRecordsFromList_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	result, err := listClient.RemoveRecordsFromList(ctx, "123", make([]string, 1500))
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, result)
+}
+
 // TestAddFromSourceList_Success tests successfully adding from source list
 func TestAddFromSourceList_Success(t *testing.T) {
 	responseJSON := `{
-		"recordIdsAdded": ["contact-1", "contact-2", "contact-3"]
+		"recordsIdsAdded": ["contact-1", "contact-2", "contact-3"]
 	}`
 
 	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -628,6 +876,47 @@ func TestAddFromSourceList_Success(t *testing.T) {
 	assert.Len(t, result.RecordIDsAdded, 3)
 }
 
+// TestAddFromSourceListFiltered_Success tests copying only the selected members of a source list
+func TestAddFromSourceListFiltered_Success(t *testing.T) {
+	var added []string
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/crm/v3/lists/456/memberships" && r.URL.Query().Get("offset") == "":
+			respondJSON(w, http.StatusOK, `{"results": ["1", "2", "3"], "hasMore": true, "offset": "page-2"}`)
+		case r.Method == "GET" && r.URL.Path == "/crm/v3/lists/456/memberships":
+			assert.Equal(t, "page-2", r.URL.Query().Get("offset"))
+			respondJSON(w, http.StatusOK, `{"results": ["4", "5"], "hasMore": false}`)
+		case r.Method == "PUT" && r.URL.Path == "/crm/v3/lists/123/memberships/add":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&added))
+			respondJSON(w, http.StatusOK, `{"recordsIdsAdded": ["2"]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	result, err := listClient.AddFromSourceListFiltered(context.Background(), "123", "456", []string{"2", "5", "9"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "5"}, added)
+	assert.Equal(t, []string{"2"}, result.RecordIDsAdded)
+	assert.Equal(t, []string{"5"}, result.RecordIDsAlreadyPresent)
+}
+
+// TestAddFromSourceListFiltered_NoMatches tests that no add request is made when nothing matches
+func TestAddFromSourceListFiltered_NoMatches(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		respondJSON(w, http.StatusOK, `{"results": ["1", "2"], "hasMore": false}`)
+	})
+	defer server.Close()
+
+	result, err := listClient.AddFromSourceListFiltered(context.Background(), "123", "456", []string{"9"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.RecordIDsAdded)
+}
+
 // TestGetListMemberships_Success tests successful retrieval of list memberships
 func TestGetListMemberships_Success(t *testing.T) {
 	responseJSON := `{
@@ -718,6 +1007,60 @@ func TestRemoveRecordsFromList_Success(t *testing.T) {
 	assert.Len(t, result.RecordIDsRemoved, 2)
 }
 
+// TestAddRecordIdentifiersToList_CustomObject tests adding custom object records to a custom object list
+func TestAddRecordIdentifiersToList_CustomObject(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/lists/123":
+			assert.Equal(t, "false", r.URL.Query().Get("includeFilters"))
+			respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "objectTypeId": "2-456"}}`)
+		case "/crm/v3/lists/123/memberships/add":
+			assert.Equal(t, "PUT", r.Method)
+			var recordIDs []string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&recordIDs))
+			assert.Equal(t, []string{"1", "2"}, recordIDs)
+			respondJSON(w, http.StatusOK, `{"recordsIdsAdded": ["1", "2"]}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	records := []MembershipRecordIdentifier{
+		{ObjectTypeID: "2-456", RecordID: "1"},
+		{RecordID: "2"},
+	}
+
+	result, err := listClient.AddRecordIdentifiersToList(context.Background(), "123", records)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, result.RecordIDsAdded)
+}
+
+// TestRemoveRecordIdentifiersFromList_ObjectTypeMismatch tests that records of another object type are rejected
+func TestRemoveRecordIdentifiersFromList_ObjectTypeMismatch(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/lists/123", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "objectTypeId": "2-456"}}`)
+	})
+	defer server.Close()
+
+	records := []MembershipRecordIdentifier{
+		{ObjectTypeID: "2-456", RecordID: "1"},
+		{ObjectTypeID: "0-1", RecordID: "2"},
+	}
+
+	result, err := listClient.RemoveRecordIdentifiersFromList(context.Background(), "123", records)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	var mismatchErr *ObjectTypeMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	assert.Equal(t, "2", mismatchErr.RecordID)
+	assert.Equal(t, "0-1", mismatchErr.RecordObjectTypeID)
+	assert.Equal(t, "2-456", mismatchErr.ListObjectTypeID)
+}
+
 // TestScheduleConversion_Success tests successfully scheduling a list conversion
 func TestScheduleConversion_Success(t *testing.T) {
 	responseJSON := `{
@@ -761,6 +1104,54 @@ func TestScheduleConversion_Success(t *testing.T) {
 	assert.Equal(t, ConversionDate, result.RequestedConversionTime.ConversionType)
 }
 
+// TestScheduleConversion_Validation tests that requests missing fields for their conversion type aren't sent
+func TestScheduleConversion_Validation(t *testing.T) {
+	server, listClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+	defer server.Close()
+
+	year, month, offset := 2024, 13, 0
+	week := Week
+	unit := TimeUnit("YEAR")
+
+	tests := []struct {
+		name   string
+		input  *ScheduleConversionRequest
+		fields []string
+	}{
+		{"CONVERSION_DATE without day", &ScheduleConversionRequest{ConversionType: ConversionDate, Year: &year, Month: &month}, []string{"month", "day"}},
+		{"INACTIVITY without offset", &ScheduleConversionRequest{ConversionType: Inactivity, TimeUnit: &week}, []string{"offset"}},
+		{"INACTIVITY with invalid values", &ScheduleConversionRequest{ConversionType: Inactivity, TimeUnit: &unit, Offset: &offset}, []string{"timeUnit", "offset"}},
+		{"Missing conversion type", &ScheduleConversionRequest{}, []string{"conversionType"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := listClient.ScheduleConversion(context.Background(), "123", tt.input)
+			require.Error(t, err)
+			assert.Nil(t, result)
+
+			var fields []string
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var validationErr *ListValidationError
+				require.ErrorAs(t, e, &validationErr)
+				fields = append(fields, validationErr.Field)
+			}
+			assert.Equal(t, tt.fields, fields)
+		})
+	}
+}
+
+// TestScheduleConversionRequest_ValidateInactivity tests a valid INACTIVITY request
+func TestScheduleConversionRequest_ValidateInactivity(t *testing.T) {
+	offset := 3
+	week := Week
+	input := &ScheduleConversionRequest{ConversionType: Inactivity, TimeUnit: &week, Offset: &offset}
+
+	assert.NoError(t, input.Validate())
+}
+
 // TestGetConversionSchedule_Success tests retrieving conversion schedule
 func TestGetConversionSchedule_Success(t *testing.T) {
 	responseJSON := `{
@@ -1011,10 +1402,12 @@ func TestScheduleConversion_InvalidJSON(t *testing.T) {
 	})
 	defer server.Close()
 
-	year := 2024
+	year, month, day := 2024, 12, 31
 	input := &ScheduleConversionRequest{
 		ConversionType: ConversionDate,
 		Year:           &year,
+		Month:          &month,
+		Day:            &day,
 	}
 
 	result, err := listClient.ScheduleConversion(context.Background(), "123", input)
@@ -1038,3 +1431,202 @@ func TestGetConversionSchedule_InvalidJSON(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
+
+// TestStreamLists_TwoPages tests streaming lists across two search pages
+func TestStreamLists_TwoPages(t *testing.T) {
+	requests := 0
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/lists/search", r.URL.Path)
+
+		var body ListSearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "customers", *body.Query)
+
+		requests++
+		if body.Offset == nil {
+			respondJSON(w, http.StatusOK, `{
+				"lists": [{"listId": "1", "name": "customers-a"}, {"listId": "2", "name": "customers-b"}],
+				"total": 3,
+				"hasMore": true,
+				"offset": 2
+			}`)
+			return
+		}
+
+		assert.Equal(t, 2, *body.Offset)
+		respondJSON(w, http.StatusOK, `{
+			"lists": [{"listId": "3", "name": "customers-c"}],
+			"total": 3,
+			"hasMore": false,
+			"offset": 3
+		}`)
+	})
+	defer server.Close()
+
+	query := "customers"
+	input := &ListSearchRequest{Query: &query}
+	lists, errs := listsClient.StreamLists(context.Background(), input)
+
+	var ids []string
+	for list := range lists {
+		ids = append(ids, list.ListID)
+	}
+
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, 2, requests)
+	assert.Nil(t, input.Offset, "caller input must not be modified")
+}
+
+// TestStreamLists_Error tests that a failed page is surfaced on the error channel
+func TestStreamLists_Error(t *testing.T) {
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "bad request", "category": "VALIDATION_ERROR"}`)
+	})
+	defer server.Close()
+
+	lists, errs := listsClient.StreamLists(context.Background(), &ListSearchRequest{})
+
+	for range lists {
+		t.Fatal("no lists expected")
+	}
+	var validationErr *ListValidationError
+	require.ErrorAs(t, <-errs, &validationErr)
+}
+
+// TestStreamLists_ContextCancelled tests that cancellation stops the stream
+func TestStreamLists_ContextCancelled(t *testing.T) {
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{
+			"lists": [{"listId": "1"}, {"listId": "2"}],
+			"hasMore": true,
+			"offset": 2
+		}`)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lists, errs := listsClient.StreamLists(ctx, nil)
+
+	<-lists
+	cancel()
+
+	for range lists {
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+// TestGetListMembersWithProperties_Success tests reading list members with their properties
+func TestGetListMembersWithProperties_Success(t *testing.T) {
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/lists/123":
+			respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "name": "Customers", "objectTypeId": "0-2"}}`)
+		case "/crm/v3/lists/123/memberships":
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			respondJSON(w, http.StatusOK, `{"results": ["11", "12"], "hasMore": true, "offset": "12"}`)
+		case "/crm/v3/objects/0-2/batch/read":
+			assert.Equal(t, "POST", r.Method)
+
+			var body objects.BatchReadObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Len(t, body.Inputs, 2)
+			assert.Equal(t, "11", body.Inputs[0].ID)
+			assert.Equal(t, "12", body.Inputs[1].ID)
+			assert.Equal(t, []string{"name", "domain"}, body.Properties)
+
+			respondJSON(w, http.StatusOK, `{
+				"status": "COMPLETE",
+				"startedAt": "2024-01-01T00:00:00Z",
+				"completedAt": "2024-01-01T00:00:01Z",
+				"results": [
+					{"id": "12", "properties": {"name": "Globex"}, "createdAt": "2024-01-01T00:00:00Z", "updatedAt": "2024-01-01T00:00:00Z", "archived": false},
+					{"id": "11", "properties": {"name": "Acme"}, "createdAt": "2024-01-01T00:00:00Z", "updatedAt": "2024-01-01T00:00:00Z", "archived": false}
+				]
+			}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	members, err := listsClient.GetListMembersWithProperties(context.Background(), "123", []string{"name", "domain"}, WithMembershipsLimit(2))
+
+	require.NoError(t, err)
+	require.Len(t, members.Results, 2)
+	assert.Equal(t, "Acme", members.Results[0].Properties["name"])
+	assert.Equal(t, "Globex", members.Results[1].Properties["name"])
+	assert.True(t, *members.HasMore)
+	assert.Equal(t, "12", *members.Offset)
+}
+
+// TestGetListMembersWithProperties_LargePage tests that a page of more than 100 members is read in ordered batches
+func TestGetListMembersWithProperties_LargePage(t *testing.T) {
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = strconv.Itoa(1000 + i)
+	}
+	page, err := json.Marshal(map[string]any{"results": ids})
+	require.NoError(t, err)
+
+	var batchSizes []int
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/lists/123":
+			respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "objectTypeId": "0-1"}}`)
+		case "/crm/v3/lists/123/memberships":
+			respondJSON(w, http.StatusOK, string(page))
+		case "/crm/v3/objects/0-1/batch/read":
+			var body objects.BatchReadObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			batchSizes = append(batchSizes, len(body.Inputs))
+
+			// Answer in reverse so ordering has to be restored
+			var results []map[string]any
+			for _, input := range slices.Backward(body.Inputs) {
+				results = append(results, map[string]any{"id": input.ID, "properties": map[string]string{}})
+			}
+			resp, err := json.Marshal(map[string]any{"status": "COMPLETE", "results": results})
+			require.NoError(t, err)
+			respondJSON(w, http.StatusOK, string(resp))
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	members, err := listsClient.GetListMembersWithProperties(context.Background(), "123", nil, WithMembershipsLimit(250))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 50}, batchSizes)
+	require.Len(t, members.Results, len(ids))
+	for i, obj := range members.Results {
+		assert.Equal(t, ids[i], obj.ID)
+	}
+}
+
+// TestGetListMembersWithProperties_Empty tests that an empty list skips the batch read
+func TestGetListMembersWithProperties_Empty(t *testing.T) {
+	server, listsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/lists/123":
+			respondJSON(w, http.StatusOK, `{"list": {"listId": "123", "objectTypeId": "0-1"}}`)
+		case "/crm/v3/lists/123/memberships":
+			respondJSON(w, http.StatusOK, `{"results": []}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	members, err := listsClient.GetListMembersWithProperties(context.Background(), "123", nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, members.Results)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
@@ -2,6 +2,8 @@ package lists
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
 )
@@ -16,6 +18,34 @@ func (e *ListNotFoundError) Error() string {
 	return fmt.Sprintf("list %s not found", e.ListID)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ListNotFoundError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
+// ListsNotFoundError is returned by GetListsByIDs, along with the lists that were found, when some requested lists
+// weren't returned
+type ListsNotFoundError struct {
+	ListIDs []string
+}
+
+func (e *ListsNotFoundError) Error() string {
+	return fmt.Sprintf("lists not found: %s", strings.Join(e.ListIDs, ", "))
+}
+
+// ListDeletedError is returned when a list has been deleted but can still be restored with RestoreList
+type ListDeletedError struct {
+	ListID    string
+	DeletedAt time.Time
+}
+
+func (e *ListDeletedError) Error() string {
+	return fmt.Sprintf("list %s was deleted at %s and can be restored", e.ListID, e.DeletedAt.Format(time.RFC3339))
+}
+
 // ListValidationError is returned on validation failures
 type ListValidationError struct {
 	Field    string
@@ -27,6 +57,14 @@ func (e *ListValidationError) Error() string {
 	return fmt.Sprintf("validation error on field %s: %s", e.Field, e.Message)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ListValidationError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
 // ListAlreadyExistsError is returned when trying to create a duplicate
 type ListAlreadyExistsError struct {
 	ListName string
@@ -37,6 +75,14 @@ func (e *ListAlreadyExistsError) Error() string {
 	return fmt.Sprintf("list with name %s already exists", e.ListName)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ListAlreadyExistsError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
 // RecordNotFoundError is returned when a record is not found in a list
 type RecordNotFoundError struct {
 	RecordID string
@@ -45,9 +91,32 @@ type RecordNotFoundError struct {
 }
 
 func (e *RecordNotFoundError) Error() string {
+	if e.ListID == "" {
+		return fmt.Sprintf("record %s not found", e.RecordID)
+	}
 	return fmt.Sprintf("record %s not found in list %s", e.RecordID, e.ListID)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *RecordNotFoundError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
+// ObjectTypeMismatchError is returned when a membership change names a record of a different object type than the list
+type ObjectTypeMismatchError struct {
+	ListID             string
+	ListObjectTypeID   string
+	RecordID           string
+	RecordObjectTypeID string
+}
+
+func (e *ObjectTypeMismatchError) Error() string {
+	return fmt.Sprintf("record %s has object type %s but list %s holds %s", e.RecordID, e.RecordObjectTypeID, e.ListID, e.ListObjectTypeID)
+}
+
 // ParseListError converts a generic HubSpot error to a list-specific error
 func ParseListError(err error, listID string) error {
 	if hubspotErr, ok := err.(*client.HubSpotError); ok {
@@ -58,7 +127,7 @@ func ParseListError(err error, listID string) error {
 				Original: hubspotErr,
 			}
 		case 400:
-			if hubspotErr.Category == "VALIDATION_ERROR" {
+			if hubspotErr.Category == client.CategoryValidation {
 				return &ListValidationError{
 					Field:    hubspotErr.Message,
 					Original: hubspotErr,
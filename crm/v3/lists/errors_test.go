@@ -3,6 +3,7 @@ package lists
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,17 @@ func TestListNotFoundError_Error(t *testing.T) {
 	assert.Equal(t, expectedMsg, err.Error())
 }
 
+// TestListDeletedError_Error tests the Error() method
+func TestListDeletedError_Error(t *testing.T) {
+	err := &ListDeletedError{
+		ListID:    "123",
+		DeletedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	expectedMsg := "list 123 was deleted at 2024-03-01T12:00:00Z and can be restored"
+	assert.Equal(t, expectedMsg, err.Error())
+}
+
 // TestListValidationError_Error tests the Error() method
 func TestListValidationError_Error(t *testing.T) {
 	err := &ListValidationError{
@@ -65,6 +77,8 @@ func TestRecordNotFoundError_Error(t *testing.T) {
 
 	expectedMsg := "record record-123 not found in list list-456"
 	assert.Equal(t, expectedMsg, err.Error())
+	err.ListID = ""
+	assert.Equal(t, "record record-123 not found", err.Error())
 }
 
 // TestParseListError_NotFound tests parsing 404 errors
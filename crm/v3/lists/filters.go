@@ -0,0 +1,172 @@
+package lists
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Canonical returns a stable serialization of the filter branch for comparison
+//
+// Filters and nested filter branches are sorted so that branches which only differ in the order of their
+// children produce the same output. HubSpot evaluates the children of a branch as a set, so ordering never
+// changes the meaning of a branch.
+func (b *FilterBranch) Canonical() string {
+	if b == nil {
+		return ""
+	}
+
+	return canonicalJSON(b.normalized())
+}
+
+// FiltersEqual reports whether two filter branches are logically equivalent, ignoring child order
+func FiltersEqual(a, b *FilterBranch) bool {
+	return a.Canonical() == b.Canonical()
+}
+
+// normalized returns a copy of the branch with its filters and nested branches in canonical order
+func (b *FilterBranch) normalized() FilterBranch {
+	n := *b
+
+	if len(b.Filters) > 0 {
+		n.Filters = slices.Clone(b.Filters)
+		slices.SortStableFunc(n.Filters, func(x, y Filter) int {
+			return strings.Compare(canonicalJSON(x), canonicalJSON(y))
+		})
+	}
+
+	if len(b.FilterBranches) > 0 {
+		n.FilterBranches = make([]FilterBranch, len(b.FilterBranches))
+		for i := range b.FilterBranches {
+			n.FilterBranches[i] = b.FilterBranches[i].normalized()
+		}
+		slices.SortStableFunc(n.FilterBranches, func(x, y FilterBranch) int {
+			return strings.Compare(canonicalJSON(x), canonicalJSON(y))
+		})
+	}
+
+	return n
+}
+
+// canonicalJSON marshals v to JSON; map keys are sorted by encoding/json so the output is stable
+func canonicalJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// FilterBranchBuilder builds a FilterBranch fluently and validates it on Build
+type FilterBranchBuilder struct {
+	branch   FilterBranch
+	children []*FilterBranchBuilder
+}
+
+// NewFilterBranch starts a filter branch of the given type
+//
+// The branch operator defaults to "OR" for Or branches and "AND" for every other type; use WithOperator to change it.
+func NewFilterBranch(branchType FilterBranchType) *FilterBranchBuilder {
+	operator := "AND"
+	if branchType == Or {
+		operator = "OR"
+	}
+
+	return &FilterBranchBuilder{
+		branch: FilterBranch{
+			FilterBranchType:     branchType,
+			FilterBranchOperator: operator,
+		},
+	}
+}
+
+// WithOperator sets the branch's filterBranchOperator
+func (b *FilterBranchBuilder) WithOperator(operator string) *FilterBranchBuilder {
+	b.branch.FilterBranchOperator = operator
+	return b
+}
+
+// WithPropertyFilter adds a PROPERTY filter comparing property using operation
+//
+// operation is the raw HubSpot operation object, e.g. {"operationType": "MULTISTRING", "operator": "IS_EQUAL_TO",
+// "values": ["Jane"]}.
+func (b *FilterBranchBuilder) WithPropertyFilter(property string, operation map[string]any) *FilterBranchBuilder {
+	return b.WithFilter(Filter{
+		FilterType: Property,
+		Property:   &property,
+		Operation:  operation,
+	})
+}
+
+// WithFilter adds a filter of any type to the branch
+func (b *FilterBranchBuilder) WithFilter(filter Filter) *FilterBranchBuilder {
+	b.branch.Filters = append(b.branch.Filters, filter)
+	return b
+}
+
+// WithNestedBranch adds child as a nested filter branch; it is built and validated along with b
+func (b *FilterBranchBuilder) WithNestedBranch(child *FilterBranchBuilder) *FilterBranchBuilder {
+	b.children = append(b.children, child)
+	return b
+}
+
+// WithAssociation sets the association fields of an ASSOCIATION branch
+func (b *FilterBranchBuilder) WithAssociation(objectTypeID string, associationTypeID int, category AssociationCategory) *FilterBranchBuilder {
+	b.branch.ObjectTypeID = &objectTypeID
+	b.branch.AssociationTypeID = &associationTypeID
+	b.branch.AssociationCategory = &category
+	return b
+}
+
+// Build validates the branch and its nested branches and returns the resulting FilterBranch
+//
+// Every problem found is returned, joined, as *ListValidationError values whose Field is the path to the
+// offending branch or filter.
+func (b *FilterBranchBuilder) Build() (*FilterBranch, error) {
+	branch, err := b.build("filterBranch")
+	if err != nil {
+		return nil, err
+	}
+	return &branch, nil
+}
+
+// build assembles the branch at path, collecting validation errors from it and its children
+func (b *FilterBranchBuilder) build(path string) (FilterBranch, error) {
+	var errs []error
+
+	branch := b.branch
+	branch.Filters = slices.Clone(b.branch.Filters)
+
+	if branch.FilterBranchType == "" {
+		errs = append(errs, &ListValidationError{Field: path + ".filterBranchType", Message: "is required"})
+	}
+	if branch.FilterBranchType == AssociationBranch && branch.AssociationTypeID == nil {
+		errs = append(errs, &ListValidationError{Field: path + ".associationTypeId", Message: "is required for ASSOCIATION branches"})
+	}
+
+	for i, filter := range branch.Filters {
+		if filter.FilterType != Property {
+			continue
+		}
+		filterPath := fmt.Sprintf("%s.filters[%d]", path, i)
+		if filter.Property == nil || *filter.Property == "" {
+			errs = append(errs, &ListValidationError{Field: filterPath + ".property", Message: "is required for PROPERTY filters"})
+		}
+		if len(filter.Operation) == 0 {
+			errs = append(errs, &ListValidationError{Field: filterPath + ".operation", Message: "is required for PROPERTY filters"})
+		}
+	}
+
+	for i, child := range b.children {
+		nested, err := child.build(fmt.Sprintf("%s.filterBranches[%d]", path, i))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		branch.FilterBranches = append(branch.FilterBranches, nested)
+	}
+
+	return branch, errors.Join(errs...)
+}
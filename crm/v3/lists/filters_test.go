@@ -0,0 +1,142 @@
+package lists
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func propertyFilter(property, value string) Filter {
+	return Filter{
+		FilterType: Property,
+		Property:   &property,
+		Operation: map[string]any{
+			"operationType": "MULTISTRING",
+			"operator":      "IS_EQUAL_TO",
+			"values":        []any{value},
+		},
+	}
+}
+
+// TestFiltersEqual_DifferentOrder tests that child order does not affect equality
+func TestFiltersEqual_DifferentOrder(t *testing.T) {
+	a := &FilterBranch{
+		FilterBranchType:     Or,
+		FilterBranchOperator: "OR",
+		FilterBranches: []FilterBranch{
+			{
+				FilterBranchType:     And,
+				FilterBranchOperator: "AND",
+				Filters: []Filter{
+					propertyFilter("firstname", "Jane"),
+					propertyFilter("lastname", "Doe"),
+				},
+			},
+			{
+				FilterBranchType:     And,
+				FilterBranchOperator: "AND",
+				Filters: []Filter{
+					propertyFilter("email", "jane@example.com"),
+				},
+			},
+		},
+	}
+	b := &FilterBranch{
+		FilterBranchType:     Or,
+		FilterBranchOperator: "OR",
+		FilterBranches: []FilterBranch{
+			{
+				FilterBranchType:     And,
+				FilterBranchOperator: "AND",
+				Filters: []Filter{
+					propertyFilter("email", "jane@example.com"),
+				},
+			},
+			{
+				FilterBranchType:     And,
+				FilterBranchOperator: "AND",
+				Filters: []Filter{
+					propertyFilter("lastname", "Doe"),
+					propertyFilter("firstname", "Jane"),
+				},
+			},
+		},
+	}
+
+	assert.True(t, FiltersEqual(a, b))
+	assert.Equal(t, a.Canonical(), b.Canonical())
+
+	// Canonicalizing must not reorder the caller's branch
+	assert.Equal(t, "firstname", *a.FilterBranches[0].Filters[0].Property)
+}
+
+// TestFiltersEqual_Different tests that differing filters compare unequal
+func TestFiltersEqual_Different(t *testing.T) {
+	a := &FilterBranch{
+		FilterBranchType:     And,
+		FilterBranchOperator: "AND",
+		Filters:              []Filter{propertyFilter("firstname", "Jane")},
+	}
+	b := &FilterBranch{
+		FilterBranchType:     And,
+		FilterBranchOperator: "AND",
+		Filters:              []Filter{propertyFilter("firstname", "John")},
+	}
+
+	assert.False(t, FiltersEqual(a, b))
+}
+
+// TestFiltersEqual_Nil tests nil branch handling
+func TestFiltersEqual_Nil(t *testing.T) {
+	var branch *FilterBranch
+	assert.Empty(t, branch.Canonical())
+	assert.True(t, FiltersEqual(nil, nil))
+	assert.False(t, FiltersEqual(nil, &FilterBranch{FilterBranchType: And}))
+}
+
+// TestFilterBranchBuilder_Build tests building a nested branch
+func TestFilterBranchBuilder_Build(t *testing.T) {
+	operation := map[string]any{
+		"operationType": "MULTISTRING",
+		"operator":      "IS_EQUAL_TO",
+		"values":        []any{"Jane"},
+	}
+
+	branch, err := NewFilterBranch(Or).
+		WithNestedBranch(NewFilterBranch(And).WithPropertyFilter("firstname", operation)).
+		WithNestedBranch(NewFilterBranch(And).
+			WithNestedBranch(NewFilterBranch(AssociationBranch).
+				WithAssociation("0-2", 279, HubspotDefined).
+				WithPropertyFilter("name", operation))).
+		Build()
+
+	require.NoError(t, err)
+	assert.Equal(t, Or, branch.FilterBranchType)
+	assert.Equal(t, "OR", branch.FilterBranchOperator)
+	require.Len(t, branch.FilterBranches, 2)
+	assert.Equal(t, propertyFilter("firstname", "Jane").Property, branch.FilterBranches[0].Filters[0].Property)
+
+	association := branch.FilterBranches[1].FilterBranches[0]
+	assert.Equal(t, "AND", association.FilterBranchOperator)
+	assert.Equal(t, 279, *association.AssociationTypeID)
+	assert.Equal(t, "0-2", *association.ObjectTypeID)
+	assert.Equal(t, HubspotDefined, *association.AssociationCategory)
+}
+
+// TestFilterBranchBuilder_Validation tests that invalid branches report every problem with its path
+func TestFilterBranchBuilder_Validation(t *testing.T) {
+	_, err := NewFilterBranch(Or).
+		WithNestedBranch(NewFilterBranch(And).WithPropertyFilter("firstname", nil)).
+		WithNestedBranch(NewFilterBranch(AssociationBranch)).
+		Build()
+
+	require.Error(t, err)
+
+	var validationErr *ListValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.ErrorContains(t, err, "filterBranch.filterBranches[0].filters[0].operation")
+	assert.ErrorContains(t, err, "filterBranch.filterBranches[1].associationTypeId")
+	assert.NotContains(t, err.Error(), "filters[0].property")
+}
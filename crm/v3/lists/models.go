@@ -1,6 +1,11 @@
 package lists
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+)
 
 // ListProcessingType represents the processing type of a list
 type ListProcessingType string
@@ -271,6 +276,22 @@ type RecordListMembership struct {
 type RecordMembershipsResponse struct {
 	Results []RecordListMembership `json:"results"`
 	Total   *int64                 `json:"total,omitempty"`
+	Paging  *MembershipsPaging     `json:"paging,omitempty"` // Set when more memberships remain after Results
+}
+
+// MembershipsPaging holds the cursor to the next page of a record's memberships
+type MembershipsPaging struct {
+	Next *struct {
+		After string `json:"after"`
+	} `json:"next,omitempty"`
+}
+
+// NextAfter returns the cursor for the next page, or "" if this is the last page
+func (r *RecordMembershipsResponse) NextAfter() string {
+	if r.Paging == nil || r.Paging.Next == nil {
+		return ""
+	}
+	return r.Paging.Next.After
 }
 
 // MembershipAddRequest represents the request to add records to a list
@@ -285,10 +306,20 @@ type MembershipAddAndRemoveRequest struct {
 	RecordIDsToRemove []string `json:"recordIdsToRemove"`
 }
 
+// MembershipBatchSize is the most records AddRecordsToList and RemoveRecordsFromList send in one request
+const MembershipBatchSize = 1000
+
 // MembershipChangeResponse represents the response from adding/removing records
+//
+// Each requested record appears in exactly one of the Added, Missing and AlreadyPresent sets after an add
 type MembershipChangeResponse struct {
-	RecordIDsAdded   []string `json:"recordIdsAdded,omitempty"`
-	RecordIDsRemoved []string `json:"recordIdsRemoved,omitempty"`
+	RecordIDsAdded   []string `json:"recordsIdsAdded,omitempty"`  // Records that were not members before the add; HubSpot misspells this key
+	RecordIDsRemoved []string `json:"recordIdsRemoved,omitempty"` // Records that were members before the remove
+	RecordIDsMissing []string `json:"recordIdsMissing,omitempty"` // Records that don't exist
+
+	// RecordIDsAlreadyPresent lists requested records that were members before the add, so adding them was a no-op.
+	// HubSpot leaves these out of the response; AddRecordsToList works them out from the request
+	RecordIDsAlreadyPresent []string `json:"-"`
 }
 
 // ListMembershipsResponse represents the response from fetching list members
@@ -298,6 +329,13 @@ type ListMembershipsResponse struct {
 	Offset  *string  `json:"offset,omitempty"`
 }
 
+// ListMembersResponse represents a page of list members with their object properties
+type ListMembersResponse struct {
+	Results []objects.Object
+	HasMore *bool
+	Offset  *string
+}
+
 // BatchReadMembershipsRequest represents a batch request to read memberships
 type BatchReadMembershipsRequest struct {
 	Inputs []MembershipRecordIdentifier `json:"inputs"`
@@ -345,6 +383,49 @@ type ScheduleConversionRequest struct {
 	Offset   *int      `json:"offset,omitempty"`
 }
 
+// Validate checks that the request has the fields its ConversionType requires
+//
+// CONVERSION_DATE requires Year, Month (1-12) and Day (1-31). INACTIVITY requires TimeUnit (DAY, WEEK or MONTH) and a
+// positive Offset. Every problem found is returned, joined, as *ListValidationError values
+func (r *ScheduleConversionRequest) Validate() error {
+	var errs []error
+	invalid := func(field, message string) {
+		errs = append(errs, &ListValidationError{Field: field, Message: message})
+	}
+
+	switch r.ConversionType {
+	case ConversionDate:
+		if r.Year == nil {
+			invalid("year", "is required for CONVERSION_DATE")
+		}
+		if r.Month == nil {
+			invalid("month", "is required for CONVERSION_DATE")
+		} else if *r.Month < 1 || *r.Month > 12 {
+			invalid("month", "must be between 1 and 12")
+		}
+		if r.Day == nil {
+			invalid("day", "is required for CONVERSION_DATE")
+		} else if *r.Day < 1 || *r.Day > 31 {
+			invalid("day", "must be between 1 and 31")
+		}
+	case Inactivity:
+		if r.TimeUnit == nil {
+			invalid("timeUnit", "is required for INACTIVITY")
+		} else if *r.TimeUnit != Day && *r.TimeUnit != Week && *r.TimeUnit != Month {
+			invalid("timeUnit", "must be DAY, WEEK or MONTH")
+		}
+		if r.Offset == nil {
+			invalid("offset", "is required for INACTIVITY")
+		} else if *r.Offset < 1 {
+			invalid("offset", "must be positive")
+		}
+	default:
+		invalid("conversionType", "must be CONVERSION_DATE or INACTIVITY")
+	}
+
+	return errors.Join(errs...)
+}
+
 // ScheduledConversionTime represents the scheduled conversion time
 type ScheduledConversionTime struct {
 	ConversionType ConversionType `json:"conversionType"`
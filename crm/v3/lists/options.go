@@ -2,6 +2,7 @@ package lists
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
 )
@@ -9,12 +10,14 @@ import (
 // GetListOption is a functional option for GetList methods (GetListById, GetListByName, GetListsByIDs)
 type GetListOption func(*client.Request)
 
-// WithIncludeFilters includes filter definitions in the response
+// WithIncludeFilters sets whether filter definitions are included in the response
+//
+// GetListByID and GetListByName include filters by default, so List.FilterBranch is only nil there when the list has
+// no filters or WithIncludeFilters(false) was passed. GetListsByIDs leaves them out unless WithIncludeFilters(true)
+// is passed
 func WithIncludeFilters(includeFilters bool) GetListOption {
 	return func(req *client.Request) {
-		if includeFilters {
-			req.AddQueryParam("includeFilters", "true")
-		}
+		req.AddQueryParam("includeFilters", strconv.FormatBool(includeFilters))
 	}
 }
 
@@ -34,3 +37,20 @@ func WithMembershipsOffset(offset string) ListMembershipsOption {
 		req.AddQueryParam("offset", offset)
 	}
 }
+
+// RecordMembershipsOption is a functional option for GetRecordMemberships
+type RecordMembershipsOption func(*client.Request)
+
+// WithRecordMembershipsLimit sets the maximum number of memberships per page
+func WithRecordMembershipsLimit(limit int) RecordMembershipsOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("limit", strconv.Itoa(limit))
+	}
+}
+
+// WithRecordMembershipsAfter sets the paging cursor returned by RecordMembershipsResponse.NextAfter
+func WithRecordMembershipsAfter(after string) RecordMembershipsOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("after", after)
+	}
+}
@@ -0,0 +1,38 @@
+package objects
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// API is the set of objects client methods, implemented by *Client
+//
+// Code that calls the objects API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	ListObjects(ctx context.Context, objectType string, opts ...ObjectsOption) ([]Object, *Paging, error)
+	CreateObject(ctx context.Context, input *CreateObjectInput, objectType string) (*Object, error)
+	ReadObject(ctx context.Context, objectType string, id string, opts ...ObjectsOption) (*Object, error)
+	ReadObjectWithAssociations(ctx context.Context, objectType string, id string, toTypes []string, opts ...ObjectsOption) (*Object, error)
+	ReadObjectRaw(ctx context.Context, objectType string, id string, opts ...ObjectsOption) (json.RawMessage, error)
+	UpdateObject(ctx context.Context, objectType string, id string, input *UpdateObjectInput, opts ...ObjectsOption) (*Object, error)
+	UpdateObjectWithAssociations(ctx context.Context, objectType string, id string, input *UpdateObjectInput, addAssociations []AssociationToAdd) (*Object, error)
+	ArchiveObject(ctx context.Context, objectType string, id string) error
+	MergeObjects(ctx context.Context, objectType string, input *MergeObjectsInput) (*Object, error)
+	MergeObjectsWithStrategy(ctx context.Context, objectType string, primaryID, mergeID string, propertyWinners map[string]string) (*Object, error)
+	BatchReadObjects(ctx context.Context, objectType string, input *BatchReadObjectsInput, opts ...ObjectsOption) (*BatchResponse, error)
+	BatchReadObjectsOrdered(ctx context.Context, objectType string, ids []string, properties []string) ([]*Object, error)
+	GetObjectsByIDProperty(ctx context.Context, objectType string, idProperty string, values []string, properties []string) ([]Object, error)
+	BatchCreateObjects(ctx context.Context, objectType string, input *BatchCreateObjectsInput, opts ...ObjectsOption) (*BatchResponse, error)
+	BatchUpdateObjects(ctx context.Context, objectType string, input *BatchUpdateObjectsInput) (*BatchResponse, error)
+	BatchCreateOrUpdateObjects(ctx context.Context, objectType string, input *BatchCreateOrUpdateObjectsInput) (*BatchResponse, error)
+	BatchArchiveObjects(ctx context.Context, objectType string, input *BatchArchiveObjectsInput) (*BatchResponse, error)
+	ArchiveMatching(ctx context.Context, objectType string, input *SearchObjectsInput, mode ArchiveMatchingMode) (*ArchiveMatchingResult, error)
+	SearchObjects(ctx context.Context, objectType string, input *SearchObjectsInput) (*SearchObjectsResponse, error)
+	CountObjects(ctx context.Context, objectType string, input *SearchObjectsInput) (int, error)
+	FindByProperty(ctx context.Context, objectType, propertyName, value string, properties []string) (*Object, error)
+	SearchByProperty(ctx context.Context, objectType, property string, op FilterOperator, value string, opts ...ObjectsOption) (*SearchObjectsResponse, error)
+	ExportViaSearch(ctx context.Context, objectType string, sortProperty string, fn func(*Object) error, opts ...ObjectsOption) error
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,165 @@
+package objects
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// autoBatcher coalesces concurrent single object reads into batch reads, as enabled by WithAutoBatch
+type autoBatcher struct {
+	window   time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	pending map[string]*pendingBatch
+}
+
+// pendingBatch collects the reads of one object type and set of read options until it is flushed
+type pendingBatch struct {
+	ctx        context.Context
+	objectType string
+	query      map[string]string
+	ids        []string
+	waiters    map[string][]chan batchResult
+	timer      *time.Timer
+}
+
+type batchResult struct {
+	obj *Object
+	err error
+}
+
+// canAutoBatch reports whether a single read with the query parameters on req can be served by a batch read
+func canAutoBatch(req *client.Request) bool {
+	for param := range req.QueryParams {
+		switch param {
+		case "properties", "propertiesWithHistory", "idProperty", "archived":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// read queues id to be read with the next batch for objectType and req's options and waits for its result
+//
+// Batches are grouped by cacheKey, which includes the account of a client.ContextWithAccessToken token, so every
+// read in a batch is made with the same token
+func (ab *autoBatcher) read(ctx context.Context, c *Client, objectType, id string, req *client.Request) (*Object, error) {
+	ch := make(chan batchResult, 1)
+	key := cacheKey(objectType, "", req)
+
+	ab.mu.Lock()
+	batch, ok := ab.pending[key]
+	if !ok {
+		batch = &pendingBatch{
+			// The batch outlives any single caller, so it must not be cancelled with the first one
+			ctx:        context.WithoutCancel(ctx),
+			objectType: objectType,
+			query:      req.QueryParams,
+			waiters:    make(map[string][]chan batchResult),
+		}
+		ab.pending[key] = batch
+		batch.timer = time.AfterFunc(ab.window, func() {
+			ab.mu.Lock()
+			if ab.pending[key] != batch {
+				ab.mu.Unlock()
+				return
+			}
+			delete(ab.pending, key)
+			ab.mu.Unlock()
+
+			ab.flush(c, batch)
+		})
+	}
+
+	if _, seen := batch.waiters[id]; !seen {
+		batch.ids = append(batch.ids, id)
+	}
+	batch.waiters[id] = append(batch.waiters[id], ch)
+
+	full := len(batch.ids) >= ab.maxBatch
+	if full {
+		delete(ab.pending, key)
+		batch.timer.Stop()
+	}
+	ab.mu.Unlock()
+
+	if full {
+		go ab.flush(c, batch)
+	}
+
+	select {
+	case result := <-ch:
+		return result.obj, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends the batch read and delivers each object, or the error, to the callers waiting on it
+func (ab *autoBatcher) flush(c *Client, batch *pendingBatch) {
+	input := &BatchReadObjectsInput{
+		Properties:            splitParam(batch.query["properties"]),
+		PropertiesWithHistory: splitParam(batch.query["propertiesWithHistory"]),
+		IDProperty:            batch.query["idProperty"],
+	}
+	for _, id := range batch.ids {
+		input.Inputs = append(input.Inputs, struct {
+			ID string `json:"id" required:"yes"`
+		}{ID: id})
+	}
+
+	var opts []ObjectsOption
+	if batch.query["archived"] == "true" {
+		opts = append(opts, WithArchived())
+	}
+
+	resp, err := c.BatchReadObjects(batch.ctx, batch.objectType, input, opts...)
+	if resp == nil {
+		for _, waiters := range batch.waiters {
+			for _, ch := range waiters {
+				ch <- batchResult{err: err}
+			}
+		}
+		return
+	}
+
+	// BatchReadObjects always reads the idProperty, so results can be keyed on its value. HubSpot may return it
+	// normalized, e.g. a lowercased email, so it is matched case-insensitively
+	found := make(map[string]Object, len(resp.Results))
+	for _, obj := range resp.Results {
+		if input.IDProperty != "" {
+			found[strings.ToLower(obj.Properties[input.IDProperty])] = obj
+		} else {
+			found[obj.ID] = obj
+		}
+	}
+
+	for id, waiters := range batch.waiters {
+		key := id
+		if input.IDProperty != "" {
+			key = strings.ToLower(id)
+		}
+		for _, ch := range waiters {
+			obj, ok := found[key]
+			if !ok {
+				ch <- batchResult{err: &ObjectNotFoundError{ObjectType: batch.objectType, ObjectID: id}}
+				continue
+			}
+			ch <- batchResult{obj: &obj}
+		}
+	}
+}
+
+// splitParam splits a comma separated query parameter, returning an empty slice when it is unset
+func splitParam(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
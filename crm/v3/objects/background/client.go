@@ -0,0 +1,117 @@
+// Package background wraps the objects, deals, companies and contacts clients with methods that don't take a
+// context.Context, e.g. NewDealsClient(dealsClient).GetDeal(id)
+//
+// It is for scripts, REPLs and one-off data tools only. Every method calls the wrapped client with
+// context.Background(), so requests and rate limit waits can't be cancelled and have no deadline beyond the client
+// timeout. Production code should call the wrapped clients directly with a real context. Other object types, such as
+// tickets or custom objects, can be reached through the objects wrapper
+package background
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+)
+
+// Client calls an objects.API with context.Background(); see objects.Client for the documentation of each method
+type Client struct {
+	api objects.API
+}
+
+// NewClient creates a new context-less wrapper around an objects client
+func NewClient(api objects.API) *Client {
+	return &Client{api: api}
+}
+
+func (c *Client) ListObjects(objectType string, opts ...objects.ObjectsOption) ([]objects.Object, *objects.Paging, error) {
+	return c.api.ListObjects(context.Background(), objectType, opts...)
+}
+
+func (c *Client) CreateObject(input *objects.CreateObjectInput, objectType string) (*objects.Object, error) {
+	return c.api.CreateObject(context.Background(), input, objectType)
+}
+
+func (c *Client) ReadObject(objectType string, id string, opts ...objects.ObjectsOption) (*objects.Object, error) {
+	return c.api.ReadObject(context.Background(), objectType, id, opts...)
+}
+
+func (c *Client) ReadObjectWithAssociations(objectType string, id string, toTypes []string, opts ...objects.ObjectsOption) (*objects.Object, error) {
+	return c.api.ReadObjectWithAssociations(context.Background(), objectType, id, toTypes, opts...)
+}
+
+func (c *Client) ReadObjectRaw(objectType string, id string, opts ...objects.ObjectsOption) (json.RawMessage, error) {
+	return c.api.ReadObjectRaw(context.Background(), objectType, id, opts...)
+}
+
+func (c *Client) UpdateObject(objectType string, id string, input *objects.UpdateObjectInput, opts ...objects.ObjectsOption) (*objects.Object, error) {
+	return c.api.UpdateObject(context.Background(), objectType, id, input, opts...)
+}
+
+func (c *Client) UpdateObjectWithAssociations(objectType string, id string, input *objects.UpdateObjectInput, addAssociations []objects.AssociationToAdd) (*objects.Object, error) {
+	return c.api.UpdateObjectWithAssociations(context.Background(), objectType, id, input, addAssociations)
+}
+
+func (c *Client) ArchiveObject(objectType string, id string) error {
+	return c.api.ArchiveObject(context.Background(), objectType, id)
+}
+
+func (c *Client) MergeObjects(objectType string, input *objects.MergeObjectsInput) (*objects.Object, error) {
+	return c.api.MergeObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) MergeObjectsWithStrategy(objectType string, primaryID, mergeID string, propertyWinners map[string]string) (*objects.Object, error) {
+	return c.api.MergeObjectsWithStrategy(context.Background(), objectType, primaryID, mergeID, propertyWinners)
+}
+
+func (c *Client) BatchReadObjects(objectType string, input *objects.BatchReadObjectsInput, opts ...objects.ObjectsOption) (*objects.BatchResponse, error) {
+	return c.api.BatchReadObjects(context.Background(), objectType, input, opts...)
+}
+
+func (c *Client) BatchReadObjectsOrdered(objectType string, ids []string, properties []string) ([]*objects.Object, error) {
+	return c.api.BatchReadObjectsOrdered(context.Background(), objectType, ids, properties)
+}
+
+func (c *Client) GetObjectsByIDProperty(objectType string, idProperty string, values []string, properties []string) ([]objects.Object, error) {
+	return c.api.GetObjectsByIDProperty(context.Background(), objectType, idProperty, values, properties)
+}
+
+func (c *Client) BatchCreateObjects(objectType string, input *objects.BatchCreateObjectsInput, opts ...objects.ObjectsOption) (*objects.BatchResponse, error) {
+	return c.api.BatchCreateObjects(context.Background(), objectType, input, opts...)
+}
+
+func (c *Client) BatchUpdateObjects(objectType string, input *objects.BatchUpdateObjectsInput) (*objects.BatchResponse, error) {
+	return c.api.BatchUpdateObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) BatchCreateOrUpdateObjects(objectType string, input *objects.BatchCreateOrUpdateObjectsInput) (*objects.BatchResponse, error) {
+	return c.api.BatchCreateOrUpdateObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) BatchArchiveObjects(objectType string, input *objects.BatchArchiveObjectsInput) (*objects.BatchResponse, error) {
+	return c.api.BatchArchiveObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) ArchiveMatching(objectType string, input *objects.SearchObjectsInput, mode objects.ArchiveMatchingMode) (*objects.ArchiveMatchingResult, error) {
+	return c.api.ArchiveMatching(context.Background(), objectType, input, mode)
+}
+
+func (c *Client) SearchObjects(objectType string, input *objects.SearchObjectsInput) (*objects.SearchObjectsResponse, error) {
+	return c.api.SearchObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) CountObjects(objectType string, input *objects.SearchObjectsInput) (int, error) {
+	return c.api.CountObjects(context.Background(), objectType, input)
+}
+
+func (c *Client) FindByProperty(objectType, propertyName, value string, properties []string) (*objects.Object, error) {
+	return c.api.FindByProperty(context.Background(), objectType, propertyName, value, properties)
+}
+
+func (c *Client) SearchByProperty(objectType, property string, op objects.FilterOperator, value string, opts ...objects.ObjectsOption) (*objects.SearchObjectsResponse, error) {
+	return c.api.SearchByProperty(context.Background(), objectType, property, op, value, opts...)
+}
+
+func (c *Client) ExportViaSearch(objectType string, sortProperty string, fn func(*objects.Object) error, opts ...objects.ObjectsOption) error {
+	return c.api.ExportViaSearch(context.Background(), objectType, sortProperty, fn, opts...)
+}
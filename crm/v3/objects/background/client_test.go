@@ -0,0 +1,95 @@
+package background
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/companies"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/contacts"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/deals"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClient_ReadObject tests that calls are forwarded to the objects client
+func TestClient_ReadObject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/42", r.URL.Path)
+		assert.Equal(t, "amount", r.URL.Query().Get("properties"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "42", "properties": {"amount": "100"}}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClient(
+		client.WithTimeout(5*time.Second),
+		client.WithBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	bg := NewClient(objects.NewClient(apiClient))
+	obj, err := bg.ReadObject("deals", "42", objects.WithProperties([]string{"amount"}))
+	require.NoError(t, err)
+	assert.Equal(t, "100", obj.Properties["amount"])
+}
+
+// TestDealsClient_GetDeal tests that calls are forwarded to the deals client
+func TestDealsClient_GetDeal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/42", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "42", "properties": {"dealname": "Renewal"}}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClient(
+		client.WithTimeout(5*time.Second),
+		client.WithBaseURL(server.URL),
+	)
+	require.NoError(t, err)
+
+	deal, err := NewDealsClient(deals.NewClient(apiClient)).GetDeal("42")
+	require.NoError(t, err)
+	assert.Equal(t, "42", deal.ID)
+}
+
+// TestClient_CoversAPI tests that each wrapper has a context-less variant of every method of the API it wraps
+func TestClient_CoversAPI(t *testing.T) {
+	t.Run("Objects", func(t *testing.T) { coversAPI[objects.API, *Client](t) })
+	t.Run("Deals", func(t *testing.T) { coversAPI[deals.API, *DealsClient](t) })
+	t.Run("Companies", func(t *testing.T) { coversAPI[companies.API, *CompaniesClient](t) })
+	t.Run("Contacts", func(t *testing.T) { coversAPI[contacts.API, *ContactsClient](t) })
+}
+
+// coversAPI checks that Wrapper has every method of API, with the same arguments after the context and results
+func coversAPI[API, Wrapper any](t *testing.T) {
+	api := reflect.TypeFor[API]()
+	clientType := reflect.TypeFor[Wrapper]()
+	ctxType := reflect.TypeFor[context.Context]()
+	for i := range api.NumMethod() {
+		want := api.Method(i)
+		got, ok := clientType.MethodByName(want.Name)
+		if !assert.True(t, ok, "%s is missing %s", clientType, want.Name) {
+			continue
+		}
+
+		require.Equal(t, ctxType, want.Type.In(0))
+		// got's receiver takes the place of want's context
+		if !assert.Equal(t, want.Type.NumIn(), got.Type.NumIn(), "%s has the wrong number of arguments", want.Name) {
+			continue
+		}
+		for j := 1; j < want.Type.NumIn(); j++ {
+			assert.Equal(t, want.Type.In(j), got.Type.In(j), "%s argument %d", want.Name, j)
+		}
+		assert.Equal(t, want.Type.NumOut(), got.Type.NumOut(), "%s has the wrong number of results", want.Name)
+		for j := range want.Type.NumOut() {
+			assert.Equal(t, want.Type.Out(j), got.Type.Out(j), "%s result %d", want.Name, j)
+		}
+	}
+}
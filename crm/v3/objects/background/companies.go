@@ -0,0 +1,77 @@
+package background
+
+import (
+	"context"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/companies"
+)
+
+// CompaniesClient calls a companies.API with context.Background(); see companies.Client for the documentation of each method
+type CompaniesClient struct {
+	api companies.API
+}
+
+// NewCompaniesClient creates a new context-less wrapper around a companies client
+func NewCompaniesClient(api companies.API) *CompaniesClient {
+	return &CompaniesClient{api: api}
+}
+
+func (c *CompaniesClient) CreateCompany(input *companies.CreateCompanyInput) (*companies.Company, error) {
+	return c.api.CreateCompany(context.Background(), input)
+}
+
+func (c *CompaniesClient) GetCompany(companyID string, opts ...companies.CompanyOption) (*companies.Company, error) {
+	return c.api.GetCompany(context.Background(), companyID, opts...)
+}
+
+func (c *CompaniesClient) UpdateCompany(companyID string, input *companies.UpdateCompanyInput) (*companies.Company, error) {
+	return c.api.UpdateCompany(context.Background(), companyID, input)
+}
+
+func (c *CompaniesClient) ArchiveCompany(companyID string) error {
+	return c.api.ArchiveCompany(context.Background(), companyID)
+}
+
+func (c *CompaniesClient) ListCompanies(opts ...companies.CompanyOption) (*companies.ListCompaniesResponse, error) {
+	return c.api.ListCompanies(context.Background(), opts...)
+}
+
+func (c *CompaniesClient) ForEachCompany(fn func(companies.Company) error, opts ...companies.CompanyOption) error {
+	return c.api.ForEachCompany(context.Background(), fn, opts...)
+}
+
+func (c *CompaniesClient) ListAllCompanies(opts ...companies.CompanyOption) ([]companies.Company, error) {
+	return c.api.ListAllCompanies(context.Background(), opts...)
+}
+
+func (c *CompaniesClient) BatchReadCompanies(input *companies.BatchReadCompaniesInput) (*companies.BatchCompaniesResponse, error) {
+	return c.api.BatchReadCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) BatchCreateCompanies(input *companies.BatchCreateCompaniesInput) (*companies.BatchCompaniesResponse, error) {
+	return c.api.BatchCreateCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) BatchUpdateCompanies(input *companies.BatchUpdateCompaniesInput) (*companies.BatchCompaniesResponse, error) {
+	return c.api.BatchUpdateCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) BatchArchiveCompanies(input *companies.BatchArchiveCompaniesInput) (*companies.BatchArchiveResponse, error) {
+	return c.api.BatchArchiveCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) SearchCompanies(input *companies.SearchCompaniesInput) (*companies.SearchCompaniesResponse, error) {
+	return c.api.SearchCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) SearchCompaniesByQuery(query string, properties []string) (*companies.SearchCompaniesResponse, error) {
+	return c.api.SearchCompaniesByQuery(context.Background(), query, properties)
+}
+
+func (c *CompaniesClient) CountCompanies(input *companies.SearchCompaniesInput) (int, error) {
+	return c.api.CountCompanies(context.Background(), input)
+}
+
+func (c *CompaniesClient) GetCompanyDealSummary(companyID string) (companies.DealSummary, error) {
+	return c.api.GetCompanyDealSummary(context.Background(), companyID)
+}
@@ -0,0 +1,41 @@
+package background
+
+import (
+	"context"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/contacts"
+)
+
+// ContactsClient calls a contacts.API with context.Background(); see contacts.Client for the documentation of each method
+type ContactsClient struct {
+	api contacts.API
+}
+
+// NewContactsClient creates a new context-less wrapper around a contacts client
+func NewContactsClient(api contacts.API) *ContactsClient {
+	return &ContactsClient{api: api}
+}
+
+func (c *ContactsClient) GetContact(contactID string, opts ...contacts.GetContactOption) (*contacts.Contact, error) {
+	return c.api.GetContact(context.Background(), contactID, opts...)
+}
+
+func (c *ContactsClient) CreateContact(input *contacts.CreateContactInput) (*contacts.Contact, error) {
+	return c.api.CreateContact(context.Background(), input)
+}
+
+func (c *ContactsClient) UpdateContact(contactID string, input *contacts.UpdateContactInput) (*contacts.Contact, error) {
+	return c.api.UpdateContact(context.Background(), contactID, input)
+}
+
+func (c *ContactsClient) DeleteContact(contactID string) error {
+	return c.api.DeleteContact(context.Background(), contactID)
+}
+
+func (c *ContactsClient) ListContacts(opts ...contacts.ListContactsOption) ([]contacts.Contact, string, error) {
+	return c.api.ListContacts(context.Background(), opts...)
+}
+
+func (c *ContactsClient) SearchContacts(input *contacts.SearchContactsInput) (*contacts.SearchContactsResponse, error) {
+	return c.api.SearchContacts(context.Background(), input)
+}
@@ -0,0 +1,81 @@
+package background
+
+import (
+	"context"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/deals"
+)
+
+// DealsClient calls a deals.API with context.Background(); see deals.Client for the documentation of each method
+type DealsClient struct {
+	api deals.API
+}
+
+// NewDealsClient creates a new context-less wrapper around a deals client
+func NewDealsClient(api deals.API) *DealsClient {
+	return &DealsClient{api: api}
+}
+
+func (c *DealsClient) CreateDeal(input *deals.CreateDealInput) (*deals.Deal, error) {
+	return c.api.CreateDeal(context.Background(), input)
+}
+
+func (c *DealsClient) GetDeal(dealID string, opts ...deals.DealOption) (*deals.Deal, error) {
+	return c.api.GetDeal(context.Background(), dealID, opts...)
+}
+
+func (c *DealsClient) UpdateDeal(dealID string, input *deals.UpdateDealInput) (*deals.Deal, error) {
+	return c.api.UpdateDeal(context.Background(), dealID, input)
+}
+
+func (c *DealsClient) ArchiveDeal(dealID string) error {
+	return c.api.ArchiveDeal(context.Background(), dealID)
+}
+
+func (c *DealsClient) ListDeals(opts ...deals.DealOption) (*deals.ListDealsResponse, error) {
+	return c.api.ListDeals(context.Background(), opts...)
+}
+
+func (c *DealsClient) ForEachDeal(fn func(deals.Deal) error, opts ...deals.DealOption) error {
+	return c.api.ForEachDeal(context.Background(), fn, opts...)
+}
+
+func (c *DealsClient) ListAllDeals(opts ...deals.DealOption) ([]deals.Deal, error) {
+	return c.api.ListAllDeals(context.Background(), opts...)
+}
+
+func (c *DealsClient) BatchReadDeals(input *deals.BatchReadDealsInput) (*deals.BatchDealsResponse, error) {
+	return c.api.BatchReadDeals(context.Background(), input)
+}
+
+func (c *DealsClient) BatchCreateDeals(input *deals.BatchCreateDealsInput) (*deals.BatchDealsResponse, error) {
+	return c.api.BatchCreateDeals(context.Background(), input)
+}
+
+func (c *DealsClient) BatchUpdateDeals(input *deals.BatchUpdateDealsInput) (*deals.BatchDealsResponse, error) {
+	return c.api.BatchUpdateDeals(context.Background(), input)
+}
+
+func (c *DealsClient) BatchArchiveDeals(input *deals.BatchArchiveDealsInput) (*deals.BatchArchiveResponse, error) {
+	return c.api.BatchArchiveDeals(context.Background(), input)
+}
+
+func (c *DealsClient) SearchDeals(input *deals.SearchDealsInput) (*deals.SearchDealsResponse, error) {
+	return c.api.SearchDeals(context.Background(), input)
+}
+
+func (c *DealsClient) SearchDealsByQuery(query string, properties []string) (*deals.SearchDealsResponse, error) {
+	return c.api.SearchDealsByQuery(context.Background(), query, properties)
+}
+
+func (c *DealsClient) CountDeals(input *deals.SearchDealsInput) (int, error) {
+	return c.api.CountDeals(context.Background(), input)
+}
+
+func (c *DealsClient) GetDealStageHistory(dealID string) ([]deals.StageTransition, error) {
+	return c.api.GetDealStageHistory(context.Background(), dealID)
+}
+
+func (c *DealsClient) CreateDealWithStage(name, pipeline, stage string, amount float64, opts ...deals.CreateOption) (*deals.Deal, error) {
+	return c.api.CreateDealWithStage(context.Background(), name, pipeline, stage, amount, opts...)
+}
@@ -0,0 +1,201 @@
+package objects
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// ObjectCache stores objects for the read-through cache enabled by WithObjectCache
+//
+// Implementations must be safe for concurrent use. Expiry is handled by the objects client, so Get may return
+// entries whose ExpiresAt has passed
+type ObjectCache interface {
+	Get(key string) (CachedObject, bool)
+	Set(key string, entry CachedObject)
+	Delete(key string)
+}
+
+// CachedObject is an object stored in an ObjectCache along with the time it stops being valid
+type CachedObject struct {
+	Object    Object
+	ExpiresAt time.Time
+}
+
+// MemoryCache is an in-memory ObjectCache backed by a map
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]CachedObject
+}
+
+// NewMemoryCache creates an empty in-memory object cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]CachedObject),
+	}
+}
+
+func (m *MemoryCache) Get(key string) (CachedObject, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[key]
+	return entry, ok
+}
+
+func (m *MemoryCache) Set(key string, entry CachedObject) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = entry
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+}
+
+// objectCache wraps an ObjectCache with the TTL and the keys stored per object, so that every variant of an
+// object (different properties, associations, ...) can be invalidated together
+type objectCache struct {
+	cache ObjectCache
+	ttl   time.Duration
+
+	mu sync.Mutex
+	// keys maps objectType/id to the keys stored for it and when each expires
+	keys      map[string]map[string]time.Time
+	nextPrune time.Time
+}
+
+// cacheKey identifies a read of objectType/id with the query parameters set on req
+//
+// Reads made for another account with client.ContextWithAccessToken are keyed by its client.TenantKey, so one
+// account's objects are never served to another
+func cacheKey(objectType, id string, req *client.Request) string {
+	values := url.Values{}
+	for k, v := range req.QueryParams {
+		values.Set(k, v)
+	}
+	if limit, ok := req.GetMetadata(associationLimitKey); ok {
+		values.Set(associationLimitKey, fmt.Sprint(limit))
+	}
+	if _, ok := req.GetMetadata(allPropertiesKey); ok {
+		values.Set(allPropertiesKey, "true")
+	}
+	key := objectType + "/" + id + "?" + values.Encode()
+	if tenant := client.TenantKey(req.Context); tenant != "" {
+		key = tenant + ":" + key
+	}
+	return key
+}
+
+// get returns a copy of the cached object for key if it hasn't expired
+func (oc *objectCache) get(key string) (*Object, bool) {
+	entry, ok := oc.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.ExpiresAt) {
+		oc.cache.Delete(key)
+		return nil, false
+	}
+
+	obj := copyObject(entry.Object)
+	return &obj, true
+}
+
+// set stores a copy of obj under key and records key against objectType/id for invalidation
+//
+// When id is an idProperty value, key is also recorded against the object's own ID, so updating or archiving the
+// object by ID drops it too
+func (oc *objectCache) set(objectType, id, key string, obj *Object) {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	now := time.Now()
+	expiresAt := now.Add(oc.ttl)
+	oc.prune(now)
+
+	for _, objectID := range []string{id, obj.ID} {
+		if objectID == "" {
+			continue
+		}
+		objectKey := objectType + "/" + objectID
+		if oc.keys[objectKey] == nil {
+			oc.keys[objectKey] = make(map[string]time.Time)
+		}
+		oc.keys[objectKey][key] = expiresAt
+	}
+
+	oc.cache.Set(key, CachedObject{Object: copyObject(*obj), ExpiresAt: expiresAt})
+}
+
+// prune drops expired entries from the cache and the key index, at most once per TTL. The caller must hold oc.mu
+func (oc *objectCache) prune(now time.Time) {
+	if now.Before(oc.nextPrune) {
+		return
+	}
+	oc.nextPrune = now.Add(oc.ttl)
+
+	for objectKey, keys := range oc.keys {
+		for key, expiresAt := range keys {
+			if !now.Before(expiresAt) {
+				oc.cache.Delete(key)
+				delete(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			delete(oc.keys, objectKey)
+		}
+	}
+}
+
+// invalidate removes every cached read of objectType/id
+//
+// Reads of the same ID made for other accounts are removed too; dropping them only costs a refetch
+func (oc *objectCache) invalidate(objectType, id string) {
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+
+	objectKey := objectType + "/" + id
+	for key := range oc.keys[objectKey] {
+		oc.cache.Delete(key)
+	}
+	delete(oc.keys, objectKey)
+}
+
+// invalidateObject drops every cached read of objectType/id when the client was created with WithObjectCache
+func (c *Client) invalidateObject(objectType, id string) {
+	if c.cache != nil {
+		c.cache.invalidate(objectType, id)
+	}
+}
+
+// copyObject returns a copy of obj that shares no maps or slices with it, so cached objects can't be changed
+// through a value returned to the caller
+func copyObject(obj Object) Object {
+	obj.Properties = maps.Clone(obj.Properties)
+	if obj.Associations != nil {
+		associations := make(map[string]AssociationResponse, len(obj.Associations))
+		for toType, assoc := range obj.Associations {
+			assoc.Results = slices.Clone(assoc.Results)
+			associations[toType] = assoc
+		}
+		obj.Associations = associations
+	}
+	if obj.PropertiesWithHistory != nil {
+		history := make(map[string][]PropertyWithHistory, len(obj.PropertiesWithHistory))
+		for name, values := range obj.PropertiesWithHistory {
+			history[name] = slices.Clone(values)
+		}
+		obj.PropertiesWithHistory = history
+	}
+	return obj
+}
@@ -1,24 +1,53 @@
 // Package objects specifies the client methods for the HubSpot CRM Objects API
+//
+// Methods take the object type as a name or type ID; the objecttypes package provides constants for the standard objects
 package objects
 
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v4/associations"
 	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
+// associationPageLimit is the maximum page size of the associations API
+const associationPageLimit = 500
+
+// maxAssociationPages is the most association pages completeAssociations reads per type before giving up
+const maxAssociationPages = 1000
+
 type Client struct {
 	apiClient *client.Client
+	cache     *objectCache
+	batcher   *autoBatcher
+	// definitions caches property definitions per object type
+	definitions    *propertyDefinitions
+	validateSchema bool
 }
 
 // NewClient creates a new objects client
-func NewClient(apiClient *client.Client) *Client {
-	return &Client{
+func NewClient(apiClient *client.Client, opts ...ClientOption) *Client {
+	c := &Client{
 		apiClient: apiClient,
+		definitions: &propertyDefinitions{
+			byType: make(map[string]definitionsEntry),
+		},
 	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // -------- Basic Methods --------
@@ -32,16 +61,24 @@ func NewClient(apiClient *client.Client) *Client {
 // WithPropertiesWithHistory
 // WithAssociations
 // WithArchived
+// WithAllowEmpty
+// WithAllProperties
 func (c *Client) ListObjects(ctx context.Context, objectType string, opts ...ObjectsOption) ([]Object, *Paging, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
+	req.WithPaging()
 
 	// Apply options
 	for _, opt := range opts {
 		opt(req)
 	}
 
+	remaining, err := c.expandAllProperties(ctx, objectType, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
 		return nil, nil, ParseObjectError(err, objectType)
@@ -49,22 +86,50 @@ func (c *Client) ListObjects(ctx context.Context, objectType string, opts ...Obj
 
 	var objResp ListObjectsResponse
 	if err := json.Unmarshal(resp.Body, &objResp); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	results := make([]*Object, len(objResp.Results))
+	for i := range objResp.Results {
+		results[i] = &objResp.Results[i]
+	}
+	if err := c.readRemainingProperties(ctx, objectType, results, remaining, req); err != nil {
+		return nil, nil, err
 	}
 
 	if len(objResp.Results) > 0 {
 		return objResp.Results, &objResp.Paging, nil
 	}
 
-	return nil, nil, fmt.Errorf("no objects found for type %s", objectType)
+	if _, ok := req.GetMetadata(allowEmptyKey); ok {
+		return []Object{}, &objResp.Paging, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w for type %s", ErrNoObjectsFound, objectType)
 }
 
 // CreateObject creates a new HubSpot object
 func (c *Client) CreateObject(ctx context.Context, input *CreateObjectInput, objectType string) (*Object, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objectType, input.Properties)
+
+	if err := tools.ValidateRequired(&withDefaults); err != nil {
+		return nil, err
+	}
+
+	if c.validateSchema {
+		if err := c.validateProperties(ctx, objectType, withDefaults.Properties); err != nil {
+			return nil, err
+		}
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
-	req.WithBody(input)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -73,7 +138,7 @@ func (c *Client) CreateObject(ctx context.Context, input *CreateObjectInput, obj
 
 	var object CreateObjectResponse
 	if err := json.Unmarshal(resp.Body, &object); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &object.Entity, nil
@@ -81,12 +146,19 @@ func (c *Client) CreateObject(ctx context.Context, input *CreateObjectInput, obj
 
 // ReadObject reads a HubSpot object by id or specified idProperty
 //
+// When the client was created with WithObjectCache, reads with the same id and options are served from the cache
+// until the TTL passes or the object is updated, archived or merged through this client. With WithAutoBatch,
+// concurrent reads that only use WithProperties, WithPropertiesWithHistory, WithIDProperty or WithArchived are
+// coalesced into a single batch read
+//
 // opts:
 // WithProperties
 // WithPropertiesWithHistory
 // WithAssociations
 // WithArchived
 // WithIDProperty
+// WithAllProperties
+// WithAssociationLimit
 func (c *Client) ReadObject(ctx context.Context, objectType string, id string, opts ...ObjectsOption) (*Object, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s/%s", objectType, id))
 	req.WithContext(ctx)
@@ -97,24 +169,224 @@ func (c *Client) ReadObject(ctx context.Context, objectType string, id string, o
 		opt(req)
 	}
 
+	remaining, err := c.expandAllProperties(ctx, objectType, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	if c.cache != nil {
+		key = cacheKey(objectType, id, req)
+		if obj, ok := c.cache.get(key); ok {
+			return obj, nil
+		}
+	}
+
+	if c.batcher != nil && canAutoBatch(req) && len(remaining) == 0 {
+		obj, err := c.batcher.read(ctx, c, objectType, id, req)
+		if err == nil && c.cache != nil {
+			c.cache.set(objectType, id, key, obj)
+		}
+		return obj, err
+	}
+
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
-		return nil, ParseObjectError(err, objectType)
+		return nil, parseReadError(err, objectType, id)
 	}
 
 	var obj Object
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	if err := c.readRemainingProperties(ctx, objectType, []*Object{&obj}, remaining, req); err != nil {
+		return nil, err
+	}
+
+	if limit, ok := req.GetMetadata(associationLimitKey); ok {
+		if err := c.completeAssociations(ctx, objectType, &obj, limit.(int)); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.cache != nil {
+		c.cache.set(objectType, id, key, &obj)
 	}
 
 	return &obj, nil
 }
 
+// maxPropertiesQueryLength bounds the encoded properties parameter that WithAllProperties puts in a request's URL,
+// keeping it well under the URL length HubSpot accepts even for object types with hundreds of properties
+const maxPropertiesQueryLength = 2000
+
+// expandAllProperties replaces the properties param with every property name of objectType when WithAllProperties is
+// set, and returns the names that didn't fit in maxPropertiesQueryLength for readRemainingProperties to read
+func (c *Client) expandAllProperties(ctx context.Context, objectType string, req *client.Request) ([]string, error) {
+	if _, ok := req.GetMetadata(allPropertiesKey); !ok {
+		return nil, nil
+	}
+
+	names, err := c.definitions.names(ctx, c.apiClient, objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Property names need no escaping, but each comma is sent as %2C
+	length := 0
+	fit := 0
+	for fit < len(names) && length+len(names[fit])+3 <= maxPropertiesQueryLength {
+		length += len(names[fit]) + 3
+		fit++
+	}
+	req.AddQueryParam("properties", strings.Join(names[:fit], ","))
+
+	return names[fit:], nil
+}
+
+// readRemainingProperties reads the properties in names for objs through batch reads, which take them in the body
+// instead of the URL, and merges them into each object's Properties
+//
+// Objects that can no longer be read, e.g. because they were archived in the meantime, keep the properties they have
+func (c *Client) readRemainingProperties(ctx context.Context, objectType string, objs []*Object, names []string, req *client.Request) error {
+	if len(names) == 0 || len(objs) == 0 {
+		return nil
+	}
+
+	var opts []ObjectsOption
+	if req.QueryParams["archived"] == "true" {
+		opts = append(opts, WithArchived())
+	}
+
+	byID := make(map[string]*Object, len(objs))
+	for _, obj := range objs {
+		byID[obj.ID] = obj
+	}
+
+	for chunk := range slices.Chunk(objs, batchReadLimit) {
+		input := &BatchReadObjectsInput{
+			PropertiesWithHistory: []string{},
+			Properties:            names,
+		}
+		for _, obj := range chunk {
+			input.Inputs = append(input.Inputs, struct {
+				ID string `json:"id" required:"yes"`
+			}{ID: obj.ID})
+		}
+
+		resp, err := c.BatchReadObjects(ctx, objectType, input, opts...)
+		if resp == nil {
+			return err
+		}
+		for _, read := range resp.Results {
+			obj, ok := byID[read.ID]
+			if !ok {
+				continue
+			}
+			if obj.Properties == nil {
+				obj.Properties = make(map[string]string, len(read.Properties))
+			}
+			maps.Copy(obj.Properties, read.Properties)
+		}
+	}
+
+	return nil
+}
+
+// completeAssociations pages through each association type of obj that HubSpot cut off inline until limit results
+// are collected, and drops inline results beyond limit. It stops with an error wrapping associations.ErrTooManyPages
+// after maxAssociationPages pages of one type, or when a page repeats the previous cursor
+func (c *Client) completeAssociations(ctx context.Context, objectType string, obj *Object, limit int) error {
+	for toType, assoc := range obj.Associations {
+		for page := 0; len(assoc.Results) < limit && assoc.Paging.Next.After != ""; page++ {
+			if page == maxAssociationPages {
+				return fmt.Errorf("associations of %s %s to %s: %w", objectType, obj.ID, toType, associations.ErrTooManyPages)
+			}
+			after := assoc.Paging.Next.After
+
+			req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s/%s/associations/%s", objectType, obj.ID, toType))
+			req.WithContext(ctx)
+			req.WithResourceType("objects")
+			req.AddQueryParam("after", after)
+			req.AddQueryParam("limit", strconv.Itoa(min(limit-len(assoc.Results), associationPageLimit)))
+
+			resp, err := c.apiClient.Do(ctx, req)
+			if err != nil {
+				return ParseObjectError(err, objectType)
+			}
+
+			var next AssociationResponse
+			if err := json.Unmarshal(resp.Body, &next); err != nil {
+				return fmt.Errorf("failed to unmarshal association response: %w", tools.DecodeError(err, resp))
+			}
+
+			assoc.Results = append(assoc.Results, next.Results...)
+			assoc.Paging = next.Paging
+			if assoc.Paging.Next.After == after {
+				return fmt.Errorf("associations paging repeated cursor %q: %w", after, associations.ErrTooManyPages)
+			}
+		}
+
+		if len(assoc.Results) > limit {
+			assoc.Results = assoc.Results[:limit]
+		}
+		obj.Associations[toType] = assoc
+	}
+
+	return nil
+}
+
+// ReadObjectWithAssociations reads a HubSpot object along with the IDs of its associated objects of toTypes
+//
+// Associations on the returned object is keyed by the associated object type, e.g. "companies". Results are limited
+// to the first page HubSpot returns inline unless WithAssociationLimit is passed; use the v4 associations client to
+// page through larger sets
+func (c *Client) ReadObjectWithAssociations(ctx context.Context, objectType string, id string, toTypes []string, opts ...ObjectsOption) (*Object, error) {
+	return c.ReadObject(ctx, objectType, id, append(slices.Clone(opts), WithAssociations(toTypes))...)
+}
+
+// ReadObjectRaw reads a HubSpot object and returns the response body untouched
+//
+// opts:
+// WithProperties
+// WithPropertiesWithHistory
+// WithAssociations
+// WithArchived
+// WithIDProperty
+func (c *Client) ReadObjectRaw(ctx context.Context, objectType string, id string, opts ...ObjectsOption) (json.RawMessage, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/%s/%s", objectType, id))
+	req.WithContext(ctx)
+	req.WithResourceType("objects")
+
+	// Apply options
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, parseReadError(err, objectType, id)
+	}
+
+	return json.RawMessage(resp.Body), nil
+}
+
 // UpdateObject updates a HubSpot object by id or specified idProperty
 //
 // opts:
 // WithIDProperty
 func (c *Client) UpdateObject(ctx context.Context, objectType string, id string, input *UpdateObjectInput, opts ...ObjectsOption) (*Object, error) {
+	if err := tools.ValidateRequired(input); err != nil {
+		return nil, err
+	}
+
+	if c.validateSchema {
+		if err := c.validateProperties(ctx, objectType, input.Properties); err != nil {
+			return nil, err
+		}
+	}
+
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/%s/%s", objectType, id))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
@@ -132,12 +404,42 @@ func (c *Client) UpdateObject(ctx context.Context, objectType string, id string,
 
 	var obj Object
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	if c.cache != nil {
+		c.cache.invalidate(objectType, id)
+		c.cache.invalidate(objectType, obj.ID)
 	}
 
 	return &obj, nil
 }
 
+// UpdateObjectWithAssociations updates an object's properties and then adds the given associations through the v4 API
+//
+// Existing associations are kept. If the update succeeds but an association can't be created, the updated object is
+// returned together with an *AssociationCreateError; associations after the failing one are not attempted.
+func (c *Client) UpdateObjectWithAssociations(ctx context.Context, objectType string, id string, input *UpdateObjectInput, addAssociations []AssociationToAdd) (*Object, error) {
+	obj, err := c.UpdateObject(ctx, objectType, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	associationsClient := associations.NewClient(c.apiClient)
+	for _, assoc := range addAssociations {
+		if _, err := associationsClient.CreateAssociation(ctx, objectType, id, assoc.ToObjectType, assoc.ToObjectID, assoc.Types); err != nil {
+			return obj, &AssociationCreateError{
+				ObjectID:     id,
+				ToObjectType: assoc.ToObjectType,
+				ToObjectID:   assoc.ToObjectID,
+				Err:          err,
+			}
+		}
+	}
+
+	return obj, nil
+}
+
 // ArchiveObject archives a HubSpot object by id
 func (c *Client) ArchiveObject(ctx context.Context, objectType string, id string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/%s/%s", objectType, id))
@@ -149,11 +451,19 @@ func (c *Client) ArchiveObject(ctx context.Context, objectType string, id string
 		return ParseObjectError(err, objectType)
 	}
 
+	if c.cache != nil {
+		c.cache.invalidate(objectType, id)
+	}
+
 	return nil
 }
 
 // MergeObjects merges two HubSpot objects by id
 func (c *Client) MergeObjects(ctx context.Context, objectType string, input *MergeObjectsInput) (*Object, error) {
+	if err := tools.ValidateRequired(input); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/merge", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
@@ -166,28 +476,89 @@ func (c *Client) MergeObjects(ctx context.Context, objectType string, input *Mer
 
 	var obj Object
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	if c.cache != nil {
+		c.cache.invalidate(objectType, input.PrimaryObjectID)
+		c.cache.invalidate(objectType, input.ObjectIDToMerge)
 	}
 
 	return &obj, nil
 }
 
+// MergeObjectsWithStrategy merges mergeID into primaryID, choosing which record's value wins per property
+//
+// propertyWinners maps a property name to the ID of the record whose value should survive, either primaryID or mergeID.
+// Values won by mergeID are copied onto the primary before merging, since HubSpot keeps the primary's values on conflict.
+// Properties not in propertyWinners follow HubSpot's default merge rules.
+func (c *Client) MergeObjectsWithStrategy(ctx context.Context, objectType string, primaryID, mergeID string, propertyWinners map[string]string) (*Object, error) {
+	var fromMerged []string
+	for property, winner := range propertyWinners {
+		switch winner {
+		case primaryID:
+		case mergeID:
+			fromMerged = append(fromMerged, property)
+		default:
+			return nil, fmt.Errorf("winner for property %s must be %s or %s, got %q", property, primaryID, mergeID, winner)
+		}
+	}
+
+	if len(fromMerged) > 0 {
+		slices.Sort(fromMerged)
+
+		merged, err := c.ReadObject(ctx, objectType, mergeID, WithProperties(fromMerged))
+		if err != nil {
+			return nil, err
+		}
+
+		patch := make(map[string]string, len(fromMerged))
+		for _, property := range fromMerged {
+			if value, ok := merged.Properties[property]; ok {
+				patch[property] = value
+			}
+		}
+
+		if len(patch) > 0 {
+			if _, err := c.UpdateObject(ctx, objectType, primaryID, &UpdateObjectInput{Properties: patch}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c.MergeObjects(ctx, objectType, &MergeObjectsInput{
+		PrimaryObjectID: primaryID,
+		ObjectIDToMerge: mergeID,
+	})
+}
+
 // -------- Batch Methods --------
 
 // BatchReadObjects reads a batch of HubSpot objects by id or unique idProperty
 //
+// Results are not guaranteed to be in input order unless WithOrderedResults is passed. Property history is returned in
+// each result's PropertiesWithHistory for the properties named in input.PropertiesWithHistory. When input.IDProperty
+// is set it is always added to the properties read, so an empty input.Properties no longer means the defaults.
+//
+// HubSpot only returns archived objects when WithArchived (or WithArchivedOnly) is passed; without it, the IDs of
+// archived objects come back in Errors as not found. With it, only archived objects are returned, and active ones are
+// reported as not found instead.
+//
 // opts:
 // WithArchived
+// WithOrderedResults
+// WithProperties (added to input.Properties)
+// WithPropertiesWithHistory (added to input.PropertiesWithHistory)
 func (c *Client) BatchReadObjects(ctx context.Context, objectType string, input *BatchReadObjectsInput, opts ...ObjectsOption) (*BatchResponse, error) {
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/read", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
-	req.WithBody(input)
 
 	// Apply options
 	for _, opt := range opts {
 		opt(req)
 	}
+	req.WithBody(batchReadBody(req, input, c.apiClient.DefaultProperties(objectType)))
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -196,7 +567,20 @@ func (c *Client) BatchReadObjects(ctx context.Context, objectType string, input
 
 	var obj BatchResponse
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	if _, ok := req.GetMetadata(orderedResultsKey); ok {
+		keys := make([]string, len(input.Inputs))
+		for i, in := range input.Inputs {
+			keys[i] = in.ID
+		}
+		obj.Results = orderResults(obj.Results, keys, func(o Object) string {
+			if input.IDProperty != "" {
+				return o.Properties[input.IDProperty]
+			}
+			return o.ID
+		})
 	}
 
 	var errors string
@@ -214,12 +598,153 @@ func (c *Client) BatchReadObjects(ctx context.Context, objectType string, input
 	return &obj, nil
 }
 
+// batchReadLimit is the most inputs HubSpot accepts in a single batch read
+const batchReadLimit = 100
+
+// BatchReadObjectsOrdered reads objects by ID and returns them in the order of ids, for joining back to input rows
+//
+// Duplicate IDs are read once, and IDs are sent in batches of 100. The result has one entry per entry of ids,
+// including duplicates, which share the same *Object; IDs that weren't found, including archived objects, are nil.
+// An error is only returned when a batch read fails outright
+func (c *Client) BatchReadObjectsOrdered(ctx context.Context, objectType string, ids []string, properties []string) ([]*Object, error) {
+	if properties == nil {
+		properties = []string{}
+	}
+
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found := make(map[string]*Object, len(unique))
+	for chunk := range slices.Chunk(unique, batchReadLimit) {
+		input := &BatchReadObjectsInput{
+			PropertiesWithHistory: []string{},
+			Properties:            properties,
+		}
+		for _, id := range chunk {
+			input.Inputs = append(input.Inputs, struct {
+				ID string `json:"id" required:"yes"`
+			}{ID: id})
+		}
+
+		// Missing IDs come back as batch errors alongside the results, and are left nil below
+		resp, err := c.BatchReadObjects(ctx, objectType, input)
+		if resp == nil {
+			return nil, err
+		}
+		for i := range resp.Results {
+			found[resp.Results[i].ID] = &resp.Results[i]
+		}
+	}
+
+	ordered := make([]*Object, len(ids))
+	for i, id := range ids {
+		ordered[i] = found[id]
+	}
+	return ordered, nil
+}
+
+// batchReadBody returns input with any properties or propertiesWithHistory set through options moved into the body
+//
+// The batch read endpoint only reads these from the body and silently ignores them as query parameters, which would
+// otherwise come back as results without the requested properties or history. When no properties are named, the
+// defaults configured with client.WithDefaultProperties are used, as for a GET. input.IDProperty is added to the
+// properties too, since results are only matched back to inputs by its value. input is not modified
+func batchReadBody(req *client.Request, input *BatchReadObjectsInput, defaults []string) *BatchReadObjectsInput {
+	body := *input
+	body.Properties = slices.Clone(input.Properties)
+	if props, ok := req.QueryParams["properties"]; ok {
+		body.Properties = append(body.Properties, splitParam(props)...)
+		delete(req.QueryParams, "properties")
+	}
+	if len(body.Properties) == 0 && len(defaults) > 0 {
+		body.Properties = defaults
+	}
+	if body.IDProperty != "" && !slices.Contains(body.Properties, body.IDProperty) {
+		body.Properties = append(body.Properties, body.IDProperty)
+	}
+	if props, ok := req.QueryParams["propertiesWithHistory"]; ok {
+		body.PropertiesWithHistory = append(slices.Clone(input.PropertiesWithHistory), splitParam(props)...)
+		delete(req.QueryParams, "propertiesWithHistory")
+	}
+	return &body
+}
+
+// GetObjectsByIDProperty batch reads objects keyed on a unique property (e.g. email) instead of the internal ID
+//
+// Values that don't match an object are reported in the returned error alongside the objects that were found
+func (c *Client) GetObjectsByIDProperty(ctx context.Context, objectType string, idProperty string, values []string, properties []string) ([]Object, error) {
+	input := &BatchReadObjectsInput{
+		PropertiesWithHistory: []string{},
+		Properties:            properties,
+		IDProperty:            idProperty,
+	}
+	if input.Properties == nil {
+		input.Properties = []string{}
+	}
+	for _, value := range values {
+		input.Inputs = append(input.Inputs, struct {
+			ID string `json:"id" required:"yes"`
+		}{ID: value})
+	}
+
+	resp, err := c.BatchReadObjects(ctx, objectType, input)
+	if resp == nil {
+		return nil, err
+	}
+
+	return resp.Results, err
+}
+
 // BatchCreateObjects creates a batch of HubSpot objects
-func (c *Client) BatchCreateObjects(ctx context.Context, objectType string, input *BatchCreateObjectsInput) (*BatchResponse, error) {
+//
+// Results are not guaranteed to be in input order unless WithOrderedResults is passed.
+//
+// opts:
+// WithOrderedResults
+func (c *Client) BatchCreateObjects(ctx context.Context, objectType string, input *BatchCreateObjectsInput, opts ...ObjectsOption) (*BatchResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objectType, withDefaults.Inputs[i].Properties)
+	}
+
+	if c.validateSchema {
+		for i, in := range withDefaults.Inputs {
+			if err := c.validateProperties(ctx, objectType, in.Properties); err != nil {
+				return nil, fmt.Errorf("input %d: %w", i, err)
+			}
+		}
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/create", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
-	req.WithBody(input)
+
+	// Apply options
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	_, ordered := req.GetMetadata(orderedResultsKey)
+	keys := make([]string, len(withDefaults.Inputs))
+	if ordered {
+		for i := range withDefaults.Inputs {
+			if withDefaults.Inputs[i].ObjectWriteTraceID == "" {
+				withDefaults.Inputs[i].ObjectWriteTraceID = strconv.Itoa(i)
+			}
+			keys[i] = withDefaults.Inputs[i].ObjectWriteTraceID
+		}
+	}
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -228,7 +753,13 @@ func (c *Client) BatchCreateObjects(ctx context.Context, objectType string, inpu
 
 	var obj BatchResponse
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to ubmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to ubmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	if ordered {
+		obj.Results = orderResults(obj.Results, keys, func(o Object) string {
+			return o.ObjectWriteTraceID
+		})
 	}
 
 	var errors string
@@ -248,19 +779,34 @@ func (c *Client) BatchCreateObjects(ctx context.Context, objectType string, inpu
 
 // BatchUpdateObjects updates a batch of HubSpot objects
 func (c *Client) BatchUpdateObjects(ctx context.Context, objectType string, input *BatchUpdateObjectsInput) (*BatchResponse, error) {
+	if c.validateSchema {
+		for i, in := range input.Inputs {
+			if err := c.validateProperties(ctx, objectType, in.Properties); err != nil {
+				return nil, fmt.Errorf("input %d: %w", i, err)
+			}
+		}
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/update", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
+	// A failed request may still have written some inputs, so their cached reads are dropped either way
+	for _, in := range input.Inputs {
+		c.invalidateObject(objectType, in.ID)
+	}
 	if err != nil {
 		return nil, ParseObjectError(err, objectType)
 	}
 
 	var obj BatchResponse
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to ubmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to ubmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+	for _, result := range obj.Results {
+		c.invalidateObject(objectType, result.ID)
 	}
 
 	var errors string
@@ -280,19 +826,34 @@ func (c *Client) BatchUpdateObjects(ctx context.Context, objectType string, inpu
 
 // BatchCreateOrUpdateObjects creates or updates a batch of HubSpot objects
 func (c *Client) BatchCreateOrUpdateObjects(ctx context.Context, objectType string, input *BatchCreateOrUpdateObjectsInput) (*BatchResponse, error) {
+	if c.validateSchema {
+		for i, in := range input.Inputs {
+			if err := c.validateProperties(ctx, objectType, in.Properties); err != nil {
+				return nil, fmt.Errorf("input %d: %w", i, err)
+			}
+		}
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/batch/upsert", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
+	// A failed request may still have written some inputs, so their cached reads are dropped either way
+	for _, in := range input.Inputs {
+		c.invalidateObject(objectType, in.ID)
+	}
 	if err != nil {
 		return nil, ParseObjectError(err, objectType)
 	}
 
 	var obj BatchResponse
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to ubmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to ubmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+	for _, result := range obj.Results {
+		c.invalidateObject(objectType, result.ID)
 	}
 
 	var errors string
@@ -318,13 +879,22 @@ func (c *Client) BatchArchiveObjects(ctx context.Context, objectType string, inp
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
+	// A failed request may still have written some inputs, so their cached reads are dropped either way
+	for _, in := range input.Inputs {
+		c.invalidateObject(objectType, in.ID)
+	}
 	if err != nil {
 		return nil, ParseObjectError(err, objectType)
 	}
 
+	// HubSpot answers a fully successful batch archive with 204 No Content
+	if len(resp.Body) == 0 {
+		return &BatchResponse{Status: Complete}, nil
+	}
+
 	var obj BatchResponse
 	if err := json.Unmarshal(resp.Body, &obj); err != nil {
-		return nil, fmt.Errorf("failed to ubmarshal object response: %w", err)
+		return nil, fmt.Errorf("failed to ubmarshal object response: %w", tools.DecodeError(err, resp))
 	}
 
 	var errors string
@@ -342,13 +912,123 @@ func (c *Client) BatchArchiveObjects(ctx context.Context, objectType string, inp
 	return &obj, nil
 }
 
+// ArchiveMatching archives every object of objectType matched by input, for clean-ups such as removing test data
+//
+// All pages of the search are collected before anything is archived, so archiving doesn't shift the result set
+// being paged through. input.After is ignored and input.Limit is only used as the page size. The IDs are then
+// archived in batches of 100. A search matching more than 10,000 objects returns an error before anything is
+// archived, since HubSpot won't page past that; narrow the filters and run it again.
+//
+// With ArchiveDryRun, the zero value of mode, nothing is archived and the result only lists the matching IDs.
+// With ArchiveConfirmed, the result counts the objects archived, and the errors of any failed batches are joined
+// and returned alongside it
+func (c *Client) ArchiveMatching(ctx context.Context, objectType string, input *SearchObjectsInput, mode ArchiveMatchingMode) (*ArchiveMatchingResult, error) {
+	ids, err := c.matchingIDs(ctx, objectType, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ArchiveMatchingResult{IDs: ids, DryRun: mode != ArchiveConfirmed}
+	if result.DryRun {
+		return result, nil
+	}
+
+	var errs []error
+	for chunk := range slices.Chunk(ids, batchReadLimit) {
+		archive := &BatchArchiveObjectsInput{}
+		for _, id := range chunk {
+			archive.Inputs = append(archive.Inputs, struct {
+				ID string `json:"id" required:"yes"`
+			}{ID: id})
+		}
+
+		resp, err := c.BatchArchiveObjects(ctx, objectType, archive)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if resp != nil {
+			result.Archived += len(chunk) - len(resp.Errors)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// matchingIDs pages through the search described by input and returns the ID of every result
+func (c *Client) matchingIDs(ctx context.Context, objectType string, input *SearchObjectsInput) ([]string, error) {
+	page := *input
+	page.After = ""
+	if page.Limit <= 0 {
+		page.Limit = searchPageSize
+	}
+	if page.Sorts == nil {
+		page.Sorts = []string{}
+	}
+	if page.FilterGroups == nil {
+		page.FilterGroups = []SearchFilterGroup{}
+	}
+	page.Properties = []string{}
+
+	var ids []string
+	for {
+		resp, err := c.search(ctx, objectType, &page)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Total > searchResultLimit {
+			return nil, fmt.Errorf("search matched %d %s, more than the %d a search can page through", resp.Total, objectType, searchResultLimit)
+		}
+		for _, obj := range resp.Results {
+			ids = append(ids, obj.ID)
+		}
+
+		page.After = resp.Paging.Next.After
+		if page.After == "" {
+			return ids, nil
+		}
+	}
+}
+
+// orderResults arranges results so that results[i] matches keys[i]
+//
+// Slots with no matching result are left as zero-value Objects; results that match no key are appended at the end
+func orderResults(results []Object, keys []string, key func(Object) string) []Object {
+	positions := make(map[string][]int, len(keys))
+	for i, k := range keys {
+		positions[k] = append(positions[k], i)
+	}
+
+	ordered := make([]Object, len(keys))
+	var unmatched []Object
+	for _, result := range results {
+		slots, ok := positions[key(result)]
+		if !ok {
+			unmatched = append(unmatched, result)
+			continue
+		}
+		for _, i := range slots {
+			ordered[i] = result
+		}
+	}
+
+	return append(ordered, unmatched...)
+}
+
 // -------- Search Methods --------
 
 // SearchObjects searches for HubSpot objects
+//
+// A *SearchValidationError is returned without sending the request if a filter's operator is missing the values it
+// needs: BETWEEN needs Value and HighValue, IN and NOT_IN need Values, and HAS_PROPERTY and NOT_HAS_PROPERTY take none
 func (c *Client) SearchObjects(ctx context.Context, objectType string, input *SearchObjectsInput) (*SearchObjectsResponse, error) {
+	if err := validateSearchFilters(input.FilterGroups); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/search", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("objects")
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -357,8 +1037,8 @@ func (c *Client) SearchObjects(ctx context.Context, objectType string, input *Se
 	}
 
 	var obj SearchObjectsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal object response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
 	}
 
 	if len(obj.Results) == 0 {
@@ -367,3 +1047,221 @@ func (c *Client) SearchObjects(ctx context.Context, objectType string, input *Se
 
 	return &obj, nil
 }
+
+// CountObjects returns how many objects of objectType match input without downloading them
+//
+// Only input's filters and query are used: the search is sent with a limit of 1 and a single property so the response
+// stays small, and the one result is discarded. A search with no matches returns 0
+func (c *Client) CountObjects(ctx context.Context, objectType string, input *SearchObjectsInput) (int, error) {
+	countInput := *input
+	countInput.Limit = 1
+	countInput.After = ""
+	countInput.Properties = []string{"hs_object_id"}
+	if countInput.Sorts == nil {
+		countInput.Sorts = []string{}
+	}
+
+	results, err := c.search(ctx, objectType, &countInput)
+	if err != nil {
+		return 0, err
+	}
+
+	return results.Total, nil
+}
+
+// FindByProperty returns the single object of objectType whose propertyName equals value
+//
+// An *ObjectNotFoundError is returned when nothing matches and a *MultipleObjectsFoundError when more than one object
+// matches, which means the property isn't unique. properties selects the properties returned on the object
+func (c *Client) FindByProperty(ctx context.Context, objectType, propertyName, value string, properties []string) (*Object, error) {
+	if properties == nil {
+		properties = []string{}
+	}
+
+	results, err := c.search(ctx, objectType, &SearchObjectsInput{
+		Limit:      2,
+		Sorts:      []string{},
+		Properties: properties,
+		FilterGroups: []SearchFilterGroup{{
+			Filters: []SearchFilter{{
+				PropertyName: propertyName,
+				Operator:     EQ,
+				Value:        value,
+			}},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(results.Results) == 0:
+		return nil, &ObjectNotFoundError{ObjectType: objectType}
+	case len(results.Results) > 1 || results.Total > 1:
+		return nil, &MultipleObjectsFoundError{
+			ObjectType:   objectType,
+			PropertyName: propertyName,
+			Value:        value,
+			Count:        max(results.Total, len(results.Results)),
+		}
+	}
+
+	return &results.Results[0], nil
+}
+
+// SearchByProperty searches objectType with a single filter comparing property to value using op
+//
+// For In and NotIn, value is a comma separated list; for HasProperty and NotHasProperty it is ignored. Between needs a
+// second value, so use SearchObjects for it. Unlike SearchObjects, no matches returns an empty result rather than an error
+//
+// opts:
+// WithProperties
+// WithLimit
+// WithAfter
+func (c *Client) SearchByProperty(ctx context.Context, objectType, property string, op FilterOperator, value string, opts ...ObjectsOption) (*SearchObjectsResponse, error) {
+	filter := SearchFilter{PropertyName: property, Operator: op}
+	switch op {
+	case Between:
+		return nil, fmt.Errorf("operator %s needs two values, use SearchObjects instead", op)
+	case In, NotIn:
+		filter.Values = strings.Split(value, ",")
+	case HasProperty, NotHasProperty:
+	default:
+		filter.Value = value
+	}
+
+	// Search takes its options in the body, so read them off the query parameters they would normally set
+	optsReq := client.NewRequest("POST", "")
+	for _, opt := range opts {
+		opt(optsReq)
+	}
+
+	input := &SearchObjectsInput{
+		After:        optsReq.QueryParams["after"],
+		Sorts:        []string{},
+		Properties:   splitParam(optsReq.QueryParams["properties"]),
+		FilterGroups: []SearchFilterGroup{{Filters: []SearchFilter{filter}}},
+	}
+	if limit := optsReq.QueryParams["limit"]; limit != "" {
+		input.Limit, _ = strconv.Atoi(limit)
+	}
+
+	return c.search(ctx, objectType, input)
+}
+
+// searchResultLimit is the maximum number of results HubSpot will page through for a single search query
+const searchResultLimit = 10000
+
+// searchPageSize is the maximum page size accepted by the search endpoints
+const searchPageSize = 200
+
+// ExportViaSearch walks every object of objectType through the search endpoint, calling fn for each one
+//
+// Each object is read with the properties named by WithProperties, plus sortProperty, and results are sorted ascending
+// by sortProperty. When
+// paging reaches HubSpot's 10,000 result cap the query is re-anchored with a sortProperty >= lastValue filter and
+// paging restarts, so the full object set can be exported. Search accepts a single sort, so objects sharing the
+// anchor value are told apart by ID: those already passed to fn are skipped when the re-anchored query returns them
+// again. sortProperty should be sortable and close to unique (e.g. hs_object_id or createdate); more than 10,000
+// objects sharing one value can't be paged past and return an error.
+// Returning an error from fn stops the export and returns that error.
+//
+// opts:
+// WithProperties
+func (c *Client) ExportViaSearch(ctx context.Context, objectType string, sortProperty string, fn func(*Object) error, opts ...ObjectsOption) error {
+	// Search takes its options in the body, so read them off the query parameters they would normally set
+	optsReq := client.NewRequest("POST", "")
+	for _, opt := range opts {
+		opt(optsReq)
+	}
+
+	requested := splitParam(optsReq.QueryParams["properties"])
+	if !slices.Contains(requested, sortProperty) {
+		requested = append(requested, sortProperty)
+	}
+
+	var after, anchor, lastValue string
+	// seen holds the IDs passed to fn whose sortProperty is lastValue, which a query re-anchored on it returns again
+	seen := make(map[string]struct{})
+
+	for {
+		input := &SearchObjectsInput{
+			Limit:        searchPageSize,
+			After:        after,
+			Sorts:        []string{sortProperty},
+			Properties:   requested,
+			FilterGroups: []SearchFilterGroup{},
+		}
+		if anchor != "" {
+			input.FilterGroups = []SearchFilterGroup{{
+				Filters: []SearchFilter{{
+					PropertyName: sortProperty,
+					Operator:     GTE,
+					Value:        anchor,
+				}},
+			}}
+		}
+
+		resp, err := c.search(ctx, objectType, input)
+		if err != nil {
+			return err
+		}
+
+		for i := range resp.Results {
+			obj := &resp.Results[i]
+			value := obj.Properties[sortProperty]
+			if _, ok := seen[obj.ID]; ok && value == lastValue {
+				continue
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+			if value != lastValue {
+				lastValue = value
+				clear(seen)
+			}
+			seen[obj.ID] = struct{}{}
+		}
+
+		after = resp.Paging.Next.After
+		if after == "" {
+			return nil
+		}
+
+		if offset, err := strconv.Atoi(after); err == nil && offset >= searchResultLimit {
+			if lastValue == "" {
+				return fmt.Errorf("cannot re-anchor search: property %s missing from results", sortProperty)
+			}
+			if lastValue == anchor {
+				return fmt.Errorf("cannot re-anchor search: more than %d objects have %s %q", searchResultLimit, sortProperty, anchor)
+			}
+			anchor = lastValue
+			after = ""
+		}
+	}
+}
+
+// search performs a search request without treating an empty result set as an error
+func (c *Client) search(ctx context.Context, objectType string, input *SearchObjectsInput) (*SearchObjectsResponse, error) {
+	if err := validateSearchFilters(input.FilterGroups); err != nil {
+		return nil, err
+	}
+
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v3/objects/%s/search", objectType))
+	req.WithContext(ctx)
+	req.WithResourceType("objects")
+	req.WithPaging()
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, ParseObjectError(err, objectType)
+	}
+
+	var obj SearchObjectsResponse
+	if err := json.Unmarshal(resp.Body, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &obj, nil
+}
@@ -2,12 +2,21 @@ package objects
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v4/associations"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -210,6 +219,39 @@ func TestListObjects_WithArchived(t *testing.T) {
 	assert.True(t, objects[0].Archived)
 }
 
+// TestListObjects_WithArchivedStatus tests that the archived parameter is sent explicitly in both states
+func TestListObjects_WithArchivedStatus(t *testing.T) {
+	for _, archived := range []bool{true, false} {
+		server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, strconv.FormatBool(archived), r.URL.Query().Get("archived"))
+			respondJSON(w, http.StatusOK, `{"results": [{"id": "1", "properties": {}, "archived": `+strconv.FormatBool(archived)+`}]}`)
+		})
+
+		objects, _, err := objectClient.ListObjects(context.Background(), "contacts", WithArchivedStatus(archived))
+
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, archived, objects[0].Archived)
+		server.Close()
+	}
+}
+
+// TestListObjects_WithArchivedOnly tests enumerating archived objects only
+func TestListObjects_WithArchivedOnly(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+		respondJSON(w, http.StatusOK, `{"results": [{"id": "1", "properties": {}, "archived": true, "archivedAt": "2024-01-01T00:00:00.000Z"}]}`)
+	})
+	defer server.Close()
+
+	objects, _, err := objectClient.ListObjects(context.Background(), "contacts", WithArchivedOnly())
+
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.True(t, objects[0].Archived)
+	assert.Equal(t, "2024-01-01T00:00:00.000Z", objects[0].ArchivedAt)
+}
+
 // TestListObjects_NoResults tests when no objects found
 func TestListObjects_NoResults(t *testing.T) {
 	objectJSON := `{
@@ -226,6 +268,23 @@ func TestListObjects_NoResults(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, objects)
 	assert.Contains(t, err.Error(), "no objects found")
+	assert.ErrorIs(t, err, ErrNoObjectsFound)
+}
+
+// TestListObjects_AllowEmpty tests that an empty page is not an error with WithAllowEmpty
+func TestListObjects_AllowEmpty(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"results": []}`)
+	})
+	defer server.Close()
+
+	objects, paging, err := objectClient.ListObjects(context.Background(), "contacts", WithAllowEmpty())
+
+	require.NoError(t, err)
+	assert.NotNil(t, objects)
+	assert.Empty(t, objects)
+	require.NotNil(t, paging)
+	assert.Empty(t, paging.Next.After)
 }
 
 // TestListObjects_InvalidJSON tests invalid JSON response
@@ -283,6 +342,77 @@ func TestCreateObject_Success(t *testing.T) {
 	assert.Equal(t, "test@example.com", object.Properties["email"])
 }
 
+// TestCreateObject_WithAssociations tests that associations are sent with the create request
+func TestCreateObject_WithAssociations(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assocs, ok := body["associations"].([]any)
+		require.True(t, ok)
+		require.Len(t, assocs, 1)
+		assoc := assocs[0].(map[string]any)
+		assert.Equal(t, "101", assoc["to"].(map[string]any)["id"])
+
+		respondJSON(w, http.StatusCreated, `{"createResourceId": "1", "entity": {"id": "1", "properties": {}}}`)
+	})
+	defer server.Close()
+
+	association := Association{}
+	association.To.ID = "101"
+	association.Types = append(association.Types, struct {
+		AssociationCategory AssociationCategory `json:"associationCategory"`
+		AssociationTypeID   int                 `json:"associationTypeId"`
+	}{AssociationCategory: HubspotDefined, AssociationTypeID: 3})
+
+	input := &CreateObjectInput{
+		Properties:   map[string]string{"dealname": "New deal"},
+		Associations: []Association{association},
+	}
+
+	_, err := objectClient.CreateObject(context.Background(), input, "deals")
+
+	require.NoError(t, err)
+}
+
+// TestCreateObject_WithCreateDefaults tests that client create defaults are applied only to unset properties
+func TestCreateObject_WithCreateDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body CreateObjectInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{
+			"email":          "test@example.com",
+			"lifecyclestage": "customer",
+			"hs_lead_status": "NEW",
+		}, body.Properties)
+
+		respondJSON(w, http.StatusCreated, `{"createResourceId": "1", "entity": {"id": "1", "properties": {}}}`)
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClient(
+		client.WithBaseURL(server.URL),
+		client.WithCreateDefaults("contacts", map[string]string{
+			"lifecyclestage": "lead",
+			"hs_lead_status": "NEW",
+		}),
+	)
+	require.NoError(t, err)
+	objectClient := NewClient(apiClient)
+
+	input := &CreateObjectInput{
+		Properties: map[string]string{
+			"email":          "test@example.com",
+			"lifecyclestage": "customer",
+		},
+	}
+
+	_, err = objectClient.CreateObject(context.Background(), input, "contacts")
+
+	require.NoError(t, err)
+	assert.Len(t, input.Properties, 2, "caller input must not be modified")
+}
+
 // TestCreateObject_ValidationError tests validation error
 func TestCreateObject_ValidationError(t *testing.T) {
 	errorJSON := `{
@@ -329,6 +459,22 @@ func TestCreateObject_InvalidJSON(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
 
+// TestCreateObject_NilInput tests that nil create inputs return an error instead of panicking
+func TestCreateObject_NilInput(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	defer server.Close()
+
+	object, err := objectClient.CreateObject(context.Background(), nil, "contacts")
+	require.Error(t, err)
+	assert.Nil(t, object)
+
+	batch, err := objectClient.BatchCreateObjects(context.Background(), "contacts", nil)
+	require.Error(t, err)
+	assert.Nil(t, batch)
+}
+
 // TestReadObject_Success tests successful object retrieval
 func TestReadObject_Success(t *testing.T) {
 	objectJSON := `{
@@ -389,6 +535,24 @@ func TestReadObject_WithOptions(t *testing.T) {
 	assert.NotNil(t, object)
 }
 
+// TestReadObject_RepeatedOptions tests that repeated property and association options accumulate
+func TestReadObject_RepeatedOptions(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "email,firstname,lastname", r.URL.Query().Get("properties"))
+		assert.Equal(t, "companies,deals", r.URL.Query().Get("associations"))
+		respondJSON(w, http.StatusOK, `{"id": "1", "properties": {}}`)
+	})
+	defer server.Close()
+
+	contactDefaults := []ObjectsOption{WithProperties([]string{"email", "firstname"}), WithAssociations([]string{"companies"})}
+	opts := append(contactDefaults, WithProperties([]string{"firstname", "lastname"}), WithAssociations([]string{"deals"}))
+
+	object, err := objectClient.ReadObject(context.Background(), "contacts", "1", opts...)
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", object.ID)
+}
+
 // TestReadObject_NotFound tests 404 error handling
 func TestReadObject_NotFound(t *testing.T) {
 	errorJSON := `{
@@ -404,8 +568,582 @@ func TestReadObject_NotFound(t *testing.T) {
 
 	object, err := objectClient.ReadObject(context.Background(), "contacts", "99999")
 
-	require.Error(t, err)
-	assert.Nil(t, object)
+	require.Error(t, err)
+	assert.Nil(t, object)
+
+	var objectErr *ObjectNotFoundError
+	require.ErrorAs(t, err, &objectErr)
+	assert.Equal(t, "99999", objectErr.ObjectID)
+
+	var notFoundErr *client.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "contacts", notFoundErr.ObjectType)
+	assert.Equal(t, "99999", notFoundErr.ObjectID)
+}
+
+// TestReadObjectWithAssociations_Success tests that inline associations are parsed into the Associations map
+func TestReadObjectWithAssociations_Success(t *testing.T) {
+	objectJSON := `{
+		"id": "1234567890",
+		"properties": {"email": "test@example.com"},
+		"createdAt": "2024-01-01T00:00:00.000Z",
+		"updatedAt": "2024-01-01T00:00:00.000Z",
+		"archived": false,
+		"associations": {
+			"companies": {
+				"results": [
+					{"id": "111", "type": "contact_to_company"},
+					{"id": "111", "type": "contact_to_company_unlabeled"}
+				]
+			},
+			"deals": {
+				"results": [
+					{"id": "222", "type": "contact_to_deal"}
+				],
+				"paging": {
+					"next": {"after": "MjIy", "link": "?after=MjIy"}
+				}
+			}
+		}
+	}`
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/1234567890", r.URL.Path)
+		assert.Equal(t, "companies,deals", r.URL.Query().Get("associations"))
+		assert.Equal(t, "email", r.URL.Query().Get("properties"))
+		respondJSON(w, http.StatusOK, objectJSON)
+	})
+	defer server.Close()
+
+	object, err := objectClient.ReadObjectWithAssociations(context.Background(), "contacts", "1234567890",
+		[]string{"companies", "deals"}, WithProperties([]string{"email"}))
+
+	require.NoError(t, err)
+	require.Len(t, object.Associations, 2)
+
+	companies := object.Associations["companies"]
+	require.Len(t, companies.Results, 2)
+	assert.Equal(t, AssociationResult{ID: "111", Type: "contact_to_company"}, companies.Results[0])
+
+	deals := object.Associations["deals"]
+	require.Len(t, deals.Results, 1)
+	assert.Equal(t, "222", deals.Results[0].ID)
+	assert.Equal(t, "MjIy", deals.Paging.Next.After)
+}
+
+// TestReadObject_AutoBatch tests that concurrent single reads collapse into one batch request
+func TestReadObject_AutoBatch(t *testing.T) {
+	var mu sync.Mutex
+	batchRequests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/crm/v3/objects/contacts/batch/read", r.URL.Path)
+
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"email"}, body.Properties)
+
+		mu.Lock()
+		batchRequests++
+		mu.Unlock()
+
+		results := make([]map[string]any, 0, len(body.Inputs))
+		for _, in := range body.Inputs {
+			if in.ID == "missing" {
+				continue
+			}
+			results = append(results, map[string]any{
+				"id":         in.ID,
+				"properties": map[string]string{"email": in.ID + "@example.com"},
+				"createdAt":  "2024-01-01T00:00:00.000Z",
+				"updatedAt":  "2024-01-01T00:00:00.000Z",
+				"archived":   false,
+			})
+		}
+		data, err := json.Marshal(map[string]any{
+			"status":      "COMPLETE",
+			"startedAt":   "2024-01-01T00:00:00.000Z",
+			"completedAt": "2024-01-01T00:00:01.000Z",
+			"results":     results,
+		})
+		require.NoError(t, err)
+		respondJSON(w, http.StatusOK, string(data))
+	})
+	defer server.Close()
+
+	batched := NewClient(objectClient.apiClient, WithAutoBatch(100*time.Millisecond, 100))
+
+	const readers = 50
+	objects := make([]*Object, readers)
+	errs := make([]error, readers)
+	var wg sync.WaitGroup
+	for i := range readers {
+		wg.Go(func() {
+			objects[i], errs[i] = batched.ReadObject(context.Background(), "contacts", strconv.Itoa(i), WithProperties([]string{"email"}))
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, batchRequests)
+	for i := range readers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, strconv.Itoa(i), objects[i].ID)
+		assert.Equal(t, strconv.Itoa(i)+"@example.com", objects[i].Properties["email"])
+	}
+
+	_, err := batched.ReadObject(context.Background(), "contacts", "missing", WithProperties([]string{"email"}))
+	var notFound *ObjectNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+// TestReadObject_AutoBatchIDProperty tests that batched reads by idProperty request it and are matched on its value
+func TestReadObject_AutoBatchIDProperty(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "email", body.IDProperty)
+		assert.Equal(t, []string{"email"}, body.Properties)
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "101", "properties": {"email": "jane@example.com"}}]}`)
+	})
+	defer server.Close()
+
+	batched := NewClient(objectClient.apiClient, WithAutoBatch(10*time.Millisecond, 100))
+
+	obj, err := batched.ReadObject(context.Background(), "contacts", "Jane@Example.com", WithIDProperty("email"))
+	require.NoError(t, err)
+	assert.Equal(t, "101", obj.ID)
+}
+
+// TestReadObject_AutoBatchDefaultProperties tests that batched reads get the configured default properties like
+// unbatched ones
+func TestReadObject_AutoBatchDefaultProperties(t *testing.T) {
+	var got [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		got = append(got, body.Properties)
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "101", "properties": {}}]}`)
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClient(client.WithBaseURL(server.URL),
+		client.WithDefaultProperties("contacts", []string{"email", "lifecyclestage"}))
+	require.NoError(t, err)
+	batched := NewClient(apiClient, WithAutoBatch(10*time.Millisecond, 100))
+
+	_, err = batched.ReadObject(context.Background(), "contacts", "101")
+	require.NoError(t, err)
+	_, err = batched.ReadObject(context.Background(), "contacts", "101", WithProperties([]string{"firstname"}))
+	require.NoError(t, err)
+	_, err = batched.BatchReadObjectsOrdered(context.Background(), "contacts", []string{"101"}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{{"email", "lifecyclestage"}, {"firstname"}, {"email", "lifecyclestage"}}, got)
+}
+
+// TestReadObject_AllProperties tests that WithAllProperties expands to every property and fetches them once per type
+func TestReadObject_AllProperties(t *testing.T) {
+	propertyCalls := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/properties/contacts":
+			propertyCalls++
+			respondJSON(w, http.StatusOK, `{"results": [{"name": "lastname"}, {"name": "email"}, {"name": "firstname"}]}`)
+		case "/crm/v3/objects/contacts/101":
+			assert.Equal(t, "email,firstname,lastname", r.URL.Query().Get("properties"))
+			respondJSON(w, http.StatusOK, `{"id": "101", "properties": {"email": "jane@example.com"}, "archived": false}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	for range 2 {
+		obj, err := objectClient.ReadObject(context.Background(), "contacts", "101", WithAllProperties())
+		require.NoError(t, err)
+		assert.Equal(t, "101", obj.ID)
+	}
+
+	assert.Equal(t, 1, propertyCalls)
+}
+
+// TestReadObject_AllPropertiesLongList tests that property names that don't fit in the URL are read with a batch
+// read and merged into the object
+func TestReadObject_AllPropertiesLongList(t *testing.T) {
+	var names []string
+	for i := range 300 {
+		names = append(names, fmt.Sprintf("custom_property_%03d", i))
+	}
+	var batchProperties []string
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/properties/contacts":
+			var results []string
+			for _, name := range names {
+				results = append(results, fmt.Sprintf(`{"name": %q}`, name))
+			}
+			respondJSON(w, http.StatusOK, `{"results": [`+strings.Join(results, ",")+`]}`)
+		case "/crm/v3/objects/contacts/101":
+			assert.LessOrEqual(t, len(r.URL.RawQuery), maxPropertiesQueryLength+len("properties="))
+			properties := map[string]string{}
+			for _, name := range strings.Split(r.URL.Query().Get("properties"), ",") {
+				properties[name] = "get"
+			}
+			data, err := json.Marshal(map[string]any{"id": "101", "properties": properties})
+			require.NoError(t, err)
+			respondJSON(w, http.StatusOK, string(data))
+		case "/crm/v3/objects/contacts/batch/read":
+			var body BatchReadObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			batchProperties = body.Properties
+			properties := map[string]string{}
+			for _, name := range body.Properties {
+				properties[name] = "batch"
+			}
+			data, err := json.Marshal(map[string]any{"status": "COMPLETE", "results": []any{map[string]any{"id": "101", "properties": properties}}})
+			require.NoError(t, err)
+			respondJSON(w, http.StatusOK, string(data))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	obj, err := objectClient.ReadObject(context.Background(), "contacts", "101", WithAllProperties())
+
+	require.NoError(t, err)
+	assert.Len(t, obj.Properties, len(names))
+	assert.Equal(t, "get", obj.Properties[names[0]])
+	assert.Equal(t, "batch", obj.Properties[names[len(names)-1]])
+	assert.NotEmpty(t, batchProperties)
+	assert.NotContains(t, batchProperties, names[0])
+}
+
+// TestPropertyDefinitions_Refresh tests that cached property definitions are fetched again once they are stale
+func TestPropertyDefinitions_Refresh(t *testing.T) {
+	propertyCalls := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		propertyCalls++
+		respondJSON(w, http.StatusOK, `{"results": [{"name": "email"}]}`)
+	})
+	defer server.Close()
+
+	_, err := objectClient.definitions.names(context.Background(), objectClient.apiClient, "contacts")
+	require.NoError(t, err)
+	_, err = objectClient.definitions.names(context.Background(), objectClient.apiClient, "contacts")
+	require.NoError(t, err)
+	assert.Equal(t, 1, propertyCalls)
+
+	for key, entry := range objectClient.definitions.byType {
+		entry.fetchedAt = time.Now().Add(-propertyDefinitionsTTL)
+		objectClient.definitions.byType[key] = entry
+	}
+	_, err = objectClient.definitions.names(context.Background(), objectClient.apiClient, "contacts")
+	require.NoError(t, err)
+	assert.Equal(t, 2, propertyCalls)
+}
+
+// TestReadObject_NonJSONBody tests that decode errors carry a truncated body and the response details
+func TestReadObject_NonJSONBody(t *testing.T) {
+	page := "<html><body>" + strings.Repeat("Bad Gateway ", 200) + "</body></html>"
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(page))
+	})
+	defer server.Close()
+
+	object, err := objectClient.ReadObject(context.Background(), "contacts", "1234567890")
+
+	assert.Nil(t, object)
+	require.Error(t, err)
+
+	var decodeErr *tools.ResponseDecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, http.StatusOK, decodeErr.StatusCode)
+	assert.Equal(t, "text/html", decodeErr.ContentType)
+	assert.Equal(t, page[:512]+"...", decodeErr.Body)
+	assert.Less(t, len(err.Error()), 700)
+
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+}
+
+// TestReadObject_CacheHit tests that repeated reads within the TTL are served from the cache
+func TestReadObject_CacheHit(t *testing.T) {
+	requests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusOK, `{"id": "1234567890", "properties": {"email": "test@example.com"}, "archived": false}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+
+	for range 3 {
+		object, err := cached.ReadObject(context.Background(), "contacts", "1234567890", WithProperties([]string{"email"}))
+		require.NoError(t, err)
+		assert.Equal(t, "test@example.com", object.Properties["email"])
+	}
+	assert.Equal(t, 1, requests)
+
+	// Different properties are a different cache entry
+	_, err := cached.ReadObject(context.Background(), "contacts", "1234567890", WithProperties([]string{"firstname"}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+// TestReadObject_CacheExpiry tests that entries are refetched once the TTL has passed
+func TestReadObject_CacheExpiry(t *testing.T) {
+	requests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusOK, `{"id": "1234567890", "properties": {}, "archived": false}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), 20*time.Millisecond))
+
+	_, err := cached.ReadObject(context.Background(), "contacts", "1234567890")
+	require.NoError(t, err)
+	_, err = cached.ReadObject(context.Background(), "contacts", "1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	time.Sleep(40 * time.Millisecond)
+
+	_, err = cached.ReadObject(context.Background(), "contacts", "1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+// TestReadObject_CacheInvalidatedOnUpdate tests that updating an object drops its cached reads
+func TestReadObject_CacheInvalidatedOnUpdate(t *testing.T) {
+	firstname := "John"
+	reads := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			firstname = "Jane"
+		case "GET":
+			reads++
+		}
+		respondJSON(w, http.StatusOK, `{"id": "1234567890", "properties": {"firstname": "`+firstname+`"}, "archived": false}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+
+	object, err := cached.ReadObject(context.Background(), "contacts", "1234567890", WithProperties([]string{"firstname"}))
+	require.NoError(t, err)
+	assert.Equal(t, "John", object.Properties["firstname"])
+
+	_, err = cached.UpdateObject(context.Background(), "contacts", "1234567890", &UpdateObjectInput{
+		Properties: map[string]string{"firstname": "Jane"},
+	})
+	require.NoError(t, err)
+
+	object, err = cached.ReadObject(context.Background(), "contacts", "1234567890", WithProperties([]string{"firstname"}))
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", object.Properties["firstname"])
+	assert.Equal(t, 2, reads)
+
+	require.NoError(t, cached.ArchiveObject(context.Background(), "contacts", "1234567890"))
+	_, err = cached.ReadObject(context.Background(), "contacts", "1234567890", WithProperties([]string{"firstname"}))
+	require.NoError(t, err)
+	assert.Equal(t, 3, reads)
+}
+
+// TestReadObject_CacheInvalidatedOnBatchWrites tests that batch updates, upserts and archives drop the cached reads
+// of every object they touch
+func TestReadObject_CacheInvalidatedOnBatchWrites(t *testing.T) {
+	reads := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET":
+			reads++
+			respondJSON(w, http.StatusOK, `{"id": "101", "properties": {}, "archived": false}`)
+		case strings.HasSuffix(r.URL.Path, "/batch/archive"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [{"id": "101", "properties": {}}]}`)
+		}
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+	read := func() {
+		_, err := cached.ReadObject(context.Background(), "contacts", "101")
+		require.NoError(t, err)
+	}
+
+	read()
+	update := &BatchUpdateObjectsInput{}
+	update.Inputs = append(update.Inputs, struct {
+		ID                 string            `json:"id" required:"yes"`
+		Properties         map[string]string `json:"properties" required:"yes"`
+		IDProperty         string            `json:"idProperty"`
+		ObjectWriteTraceID string            `json:"objectWriteTraceId"`
+	}{ID: "101", Properties: map[string]string{"firstname": "Jane"}})
+	_, err := cached.BatchUpdateObjects(context.Background(), "contacts", update)
+	require.NoError(t, err)
+	read()
+	assert.Equal(t, 2, reads)
+
+	upsert := &BatchCreateOrUpdateObjectsInput{}
+	upsert.Inputs = append(upsert.Inputs, struct {
+		ID                 string            `json:"id" required:"yes"`
+		Properties         map[string]string `json:"properties" required:"yes"`
+		IDProperty         string            `json:"idProperty"`
+		ObjectWriteTraceID string            `json:"objectWriteTraceId"`
+	}{ID: "jane@example.com", IDProperty: "email", Properties: map[string]string{"firstname": "Jane"}})
+	_, err = cached.BatchCreateOrUpdateObjects(context.Background(), "contacts", upsert)
+	require.NoError(t, err)
+	read()
+	assert.Equal(t, 3, reads, "the upsert result's ID is invalidated")
+
+	archive := &BatchArchiveObjectsInput{}
+	archive.Inputs = append(archive.Inputs, struct {
+		ID string `json:"id" required:"yes"`
+	}{ID: "101"})
+	_, err = cached.BatchArchiveObjects(context.Background(), "contacts", archive)
+	require.NoError(t, err)
+	read()
+	assert.Equal(t, 4, reads)
+}
+
+// TestReadObject_CacheIDPropertyInvalidatedByID tests that a read by idProperty is dropped when the object is updated
+// by its ID
+func TestReadObject_CacheIDPropertyInvalidatedByID(t *testing.T) {
+	reads := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			reads++
+		}
+		respondJSON(w, http.StatusOK, `{"id": "101", "properties": {"email": "jane@example.com"}, "archived": false}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+
+	_, err := cached.ReadObject(context.Background(), "contacts", "jane@example.com", WithIDProperty("email"))
+	require.NoError(t, err)
+
+	_, err = cached.UpdateObject(context.Background(), "contacts", "101", &UpdateObjectInput{Properties: map[string]string{"firstname": "Jane"}})
+	require.NoError(t, err)
+
+	_, err = cached.ReadObject(context.Background(), "contacts", "jane@example.com", WithIDProperty("email"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, reads)
+}
+
+// TestReadObject_CacheReturnsCopies tests that changing a returned object doesn't change the cached one
+func TestReadObject_CacheReturnsCopies(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"id": "101", "properties": {"email": "jane@example.com"},
+			"associations": {"companies": {"results": [{"id": "1", "type": "contact_to_company"}]}}}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+
+	first, err := cached.ReadObject(context.Background(), "contacts", "101")
+	require.NoError(t, err)
+	first.Properties["email"] = "changed@example.com"
+	first.Associations["companies"].Results[0].ID = "2"
+
+	second, err := cached.ReadObject(context.Background(), "contacts", "101")
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", second.Properties["email"])
+	assert.Equal(t, "1", second.Associations["companies"].Results[0].ID)
+
+	second.Properties["email"] = "changed@example.com"
+	third, err := cached.ReadObject(context.Background(), "contacts", "101")
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", third.Properties["email"])
+}
+
+// TestObjectCache_Prune tests that expired entries are dropped from the cache and its key index
+func TestObjectCache_Prune(t *testing.T) {
+	memory := NewMemoryCache()
+	oc := &objectCache{cache: memory, ttl: 10 * time.Millisecond, keys: make(map[string]map[string]time.Time)}
+
+	oc.set("contacts", "1", "contacts/1?", &Object{ID: "1"})
+	time.Sleep(20 * time.Millisecond)
+	oc.set("contacts", "2", "contacts/2?", &Object{ID: "2"})
+
+	assert.NotContains(t, oc.keys, "contacts/1")
+	assert.Contains(t, oc.keys, "contacts/2")
+	_, ok := memory.Get("contacts/1?")
+	assert.False(t, ok)
+}
+
+// TestReadObject_CachePerTenant tests that reads made with another account's context token don't share cache entries
+func TestReadObject_CachePerTenant(t *testing.T) {
+	requests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		email := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ") + "@example.com"
+		respondJSON(w, http.StatusOK, `{"id": "101", "properties": {"email": "`+email+`"}, "archived": false}`)
+	})
+	defer server.Close()
+
+	cached := NewClient(objectClient.apiClient, WithObjectCache(NewMemoryCache(), time.Minute))
+	tenantA := client.ContextWithAccessToken(context.Background(), "tenant-a")
+	tenantB := client.ContextWithAccessToken(context.Background(), "tenant-b")
+
+	for range 2 {
+		object, err := cached.ReadObject(tenantA, "contacts", "101")
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-a@example.com", object.Properties["email"])
+	}
+	object, err := cached.ReadObject(tenantB, "contacts", "101")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-b@example.com", object.Properties["email"])
+	assert.Equal(t, 2, requests)
+}
+
+// TestReadObject_AutoBatchPerTenant tests that concurrent reads for different accounts are sent in separate batches
+// with their own tokens
+func TestReadObject_AutoBatchPerTenant(t *testing.T) {
+	var mu sync.Mutex
+	tokens := map[string][]string{}
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		mu.Lock()
+		for _, in := range body.Inputs {
+			tokens[r.Header.Get("Authorization")] = append(tokens[r.Header.Get("Authorization")], in.ID)
+		}
+		mu.Unlock()
+
+		results := make([]map[string]any, 0, len(body.Inputs))
+		for _, in := range body.Inputs {
+			results = append(results, map[string]any{"id": in.ID, "properties": map[string]string{}})
+		}
+		data, err := json.Marshal(map[string]any{"status": "COMPLETE", "results": results})
+		require.NoError(t, err)
+		respondJSON(w, http.StatusOK, string(data))
+	})
+	defer server.Close()
+
+	batched := NewClient(objectClient.apiClient, WithAutoBatch(50*time.Millisecond, 100))
+
+	var wg sync.WaitGroup
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		ctx := client.ContextWithAccessToken(context.Background(), tenant)
+		wg.Go(func() {
+			_, err := batched.ReadObject(ctx, "contacts", tenant)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string][]string{
+		"Bearer tenant-a": {"tenant-a"},
+		"Bearer tenant-b": {"tenant-b"},
+	}, tokens)
 }
 
 // TestReadObject_InvalidJSON tests invalid JSON response
@@ -423,6 +1161,40 @@ func TestReadObject_InvalidJSON(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
 
+// TestReadObjectRaw_Success tests that the raw response body is returned byte-for-byte
+func TestReadObjectRaw_Success(t *testing.T) {
+	objectJSON := `{"id":"123",  "properties": {"email": "test@example.com", "custom_field": null},
+		"createdAt": "2024-01-01T00:00:00.000Z", "unmodeledField": [1, 2, 3]}`
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/crm/v3/objects/contacts/123", r.URL.Path)
+		assert.Equal(t, "email", r.URL.Query().Get("properties"))
+		respondJSON(w, http.StatusOK, objectJSON)
+	})
+	defer server.Close()
+
+	raw, err := objectClient.ReadObjectRaw(context.Background(), "contacts", "123", WithProperties([]string{"email"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte(objectJSON), []byte(raw))
+}
+
+// TestReadObjectRaw_NotFound tests not found error on raw read
+func TestReadObjectRaw_NotFound(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "Object not found"}`)
+	})
+	defer server.Close()
+
+	raw, err := objectClient.ReadObjectRaw(context.Background(), "contacts", "999")
+
+	require.Error(t, err)
+	assert.Nil(t, raw)
+	var notFound *ObjectNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
 // TestUpdateObject_Success tests successful object update
 func TestUpdateObject_Success(t *testing.T) {
 	responseJSON := `{
@@ -457,6 +1229,113 @@ func TestUpdateObject_Success(t *testing.T) {
 	assert.Equal(t, "Jane", object.Properties["firstname"])
 }
 
+// TestUpdateObject_SchemaValidation tests that invalid values are rejected before the request is sent
+func TestUpdateObject_SchemaValidation(t *testing.T) {
+	propertiesJSON := `{
+		"results": [
+			{"name": "email", "type": "string", "fieldType": "text"},
+			{"name": "notes", "type": "string", "fieldType": "textarea"},
+			{"name": "phone", "type": "string", "fieldType": "phonenumber"},
+			{"name": "lifecyclestage", "type": "enumeration", "fieldType": "select", "options": [{"value": "lead"}, {"value": "customer"}]}
+		]
+	}`
+
+	propertyRequests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/properties/contacts":
+			propertyRequests++
+			respondJSON(w, http.StatusOK, propertiesJSON)
+		case "/crm/v3/objects/contacts/1234567890":
+			assert.Equal(t, "PATCH", r.Method)
+			respondJSON(w, http.StatusOK, `{"id": "1234567890", "properties": {}, "archived": false}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	validated := NewClient(objectClient.apiClient, WithSchemaValidation())
+
+	_, err := validated.UpdateObject(context.Background(), "contacts", "1234567890", &UpdateObjectInput{
+		Properties: map[string]string{
+			"email": "not-an-email",
+			"notes": strings.Repeat("a", 65537),
+		},
+	})
+
+	require.Error(t, err)
+	var validationErr *ObjectValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "email", validationErr.Field)
+	assert.Contains(t, err.Error(), "not a valid email address")
+	assert.Contains(t, err.Error(), "validation error on field notes")
+	assert.Contains(t, err.Error(), "maximum is 65536")
+
+	_, err = validated.UpdateObject(context.Background(), "contacts", "1234567890", &UpdateObjectInput{
+		Properties: map[string]string{"lifecyclestage": "prospect", "favorite_color": "blue", "phone": "call me"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field favorite_color: property does not exist on contacts")
+	assert.Contains(t, err.Error(), `"prospect" is not one of the property's options`)
+	assert.Contains(t, err.Error(), "not a valid phone number")
+
+	_, err = validated.UpdateObject(context.Background(), "contacts", "1234567890", &UpdateObjectInput{
+		Properties: map[string]string{
+			"email":          "jane@example.com",
+			"phone":          "+1 (555) 010-0100 ext 12",
+			"lifecyclestage": "customer",
+			"notes":          "",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, propertyRequests, "definitions are cached, and refetched once for the unknown favorite_color")
+}
+
+// TestSchemaValidation_NewPropertyAndUpsert tests that a property created after the definitions were cached is
+// accepted, and that upserts are validated
+func TestSchemaValidation_NewPropertyAndUpsert(t *testing.T) {
+	propertiesJSON := `{"results": [{"name": "email", "type": "string", "fieldType": "text"}]}`
+	writes := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/properties/contacts":
+			respondJSON(w, http.StatusOK, propertiesJSON)
+		default:
+			writes++
+			respondJSON(w, http.StatusOK, `{"id": "101", "status": "COMPLETE", "properties": {}, "results": []}`)
+		}
+	})
+	defer server.Close()
+
+	validated := NewClient(objectClient.apiClient, WithSchemaValidation())
+
+	_, err := validated.UpdateObject(context.Background(), "contacts", "101", &UpdateObjectInput{
+		Properties: map[string]string{"email": "jane@example.com"},
+	})
+	require.NoError(t, err)
+
+	propertiesJSON = `{"results": [{"name": "email", "type": "string", "fieldType": "text"}, {"name": "score", "type": "number", "fieldType": "number"}]}`
+	_, err = validated.UpdateObject(context.Background(), "contacts", "101", &UpdateObjectInput{
+		Properties: map[string]string{"score": "10"},
+	})
+	require.NoError(t, err)
+
+	upsert := &BatchCreateOrUpdateObjectsInput{}
+	upsert.Inputs = append(upsert.Inputs, struct {
+		ID                 string            `json:"id" required:"yes"`
+		Properties         map[string]string `json:"properties" required:"yes"`
+		IDProperty         string            `json:"idProperty"`
+		ObjectWriteTraceID string            `json:"objectWriteTraceId"`
+	}{ID: "jane@example.com", IDProperty: "email", Properties: map[string]string{"score": "high"}})
+	_, err = validated.BatchCreateOrUpdateObjects(context.Background(), "contacts", upsert)
+
+	var validationErr *ObjectValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "score", validationErr.Field)
+	assert.Equal(t, 2, writes, "the invalid upsert is not sent")
+}
+
 // TestUpdateObject_WithIDProperty tests update with custom ID property
 func TestUpdateObject_WithIDProperty(t *testing.T) {
 	responseJSON := `{
@@ -563,6 +1442,106 @@ func TestUpdateObject_InvalidJSON(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
 
+// TestUpdateObjectWithAssociations_Success tests updating an object and adding associations
+func TestUpdateObjectWithAssociations_Success(t *testing.T) {
+	var calls []string
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/crm/v3/objects/deals/100":
+			assert.Equal(t, "PATCH", r.Method)
+			respondJSON(w, http.StatusOK, `{"id": "100", "properties": {"dealstage": "closedwon"}, "archived": false}`)
+		case "/crm/v4/objects/deals/100/associations/companies/200":
+			assert.Equal(t, "PUT", r.Method)
+
+			var specs []associations.AssociationSpec
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&specs))
+			require.Len(t, specs, 1)
+			assert.Equal(t, 5, specs[0].AssociationTypeID)
+
+			respondJSON(w, http.StatusOK, `{"fromObjectTypeId": "0-3", "fromObjectId": 100, "toObjectTypeId": "0-2", "toObjectId": 200}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	object, err := objectClient.UpdateObjectWithAssociations(
+		context.Background(),
+		"deals",
+		"100",
+		&UpdateObjectInput{Properties: map[string]string{"dealstage": "closedwon"}},
+		[]AssociationToAdd{{
+			ToObjectType: "companies",
+			ToObjectID:   "200",
+			Types: []associations.AssociationSpec{
+				{AssociationCategory: associations.AssociationCategoryHubSpotDefined, AssociationTypeID: 5},
+			},
+		}},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "closedwon", object.Properties["dealstage"])
+	assert.Equal(t, []string{
+		"PATCH /crm/v3/objects/deals/100",
+		"PUT /crm/v4/objects/deals/100/associations/companies/200",
+	}, calls)
+}
+
+// TestUpdateObjectWithAssociations_AssociationFails tests that an association failure is reported separately
+func TestUpdateObjectWithAssociations_AssociationFails(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			respondJSON(w, http.StatusOK, `{"id": "100", "properties": {"dealstage": "closedwon"}, "archived": false}`)
+			return
+		}
+		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "invalid association type", "category": "VALIDATION_ERROR"}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.UpdateObjectWithAssociations(
+		context.Background(),
+		"deals",
+		"100",
+		&UpdateObjectInput{Properties: map[string]string{"dealstage": "closedwon"}},
+		[]AssociationToAdd{{ToObjectType: "companies", ToObjectID: "200"}},
+	)
+
+	require.Error(t, err)
+	var assocErr *AssociationCreateError
+	require.ErrorAs(t, err, &assocErr)
+	assert.Equal(t, "companies", assocErr.ToObjectType)
+	assert.Equal(t, "200", assocErr.ToObjectID)
+
+	var hubspotErr *client.HubSpotError
+	assert.ErrorAs(t, err, &hubspotErr)
+
+	require.NotNil(t, object, "the update succeeded so the object is still returned")
+	assert.Equal(t, "100", object.ID)
+}
+
+// TestUpdateObjectWithAssociations_UpdateFails tests that no associations are created when the update fails
+func TestUpdateObjectWithAssociations_UpdateFails(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "not found", "category": "OBJECT_NOT_FOUND"}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.UpdateObjectWithAssociations(
+		context.Background(),
+		"deals",
+		"100",
+		&UpdateObjectInput{Properties: map[string]string{"dealstage": "closedwon"}},
+		[]AssociationToAdd{{ToObjectType: "companies", ToObjectID: "200"}},
+	)
+
+	assert.Nil(t, object)
+	var notFound *ObjectNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
 // TestArchiveObject_Success tests successful object archival
 func TestArchiveObject_Success(t *testing.T) {
 	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -628,6 +1607,75 @@ func TestMergeObjects_Success(t *testing.T) {
 	assert.Equal(t, "1234567890", object.ID)
 }
 
+// TestMergeObjectsWithStrategy_Success tests that winning values are patched onto the primary before merging
+func TestMergeObjectsWithStrategy_Success(t *testing.T) {
+	var calls []string
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /crm/v3/objects/contacts/200":
+			assert.Equal(t, "lastname,phone", r.URL.Query().Get("properties"))
+			respondJSON(w, http.StatusOK, `{"id": "200", "properties": {"lastname": "Smith", "phone": "555-0100"}, "archived": false}`)
+		case "PATCH /crm/v3/objects/contacts/100":
+			var body UpdateObjectInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]string{"lastname": "Smith", "phone": "555-0100"}, body.Properties)
+			respondJSON(w, http.StatusOK, `{"id": "100", "properties": {"lastname": "Smith", "phone": "555-0100"}, "archived": false}`)
+		case "POST /crm/v3/objects/contacts/merge":
+			respondJSON(w, http.StatusOK, `{"id": "100", "properties": {"firstname": "Jane", "lastname": "Smith", "phone": "555-0100"}, "archived": false}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	object, err := objectClient.MergeObjectsWithStrategy(context.Background(), "contacts", "100", "200", map[string]string{
+		"firstname": "100",
+		"lastname":  "200",
+		"phone":     "200",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Smith", object.Properties["lastname"])
+	assert.Equal(t, []string{
+		"GET /crm/v3/objects/contacts/200",
+		"PATCH /crm/v3/objects/contacts/100",
+		"POST /crm/v3/objects/contacts/merge",
+	}, calls)
+}
+
+// TestMergeObjectsWithStrategy_PrimaryWins tests that no patch is sent when the primary wins every property
+func TestMergeObjectsWithStrategy_PrimaryWins(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/merge", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"id": "100", "properties": {}, "archived": false}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.MergeObjectsWithStrategy(context.Background(), "contacts", "100", "200", map[string]string{
+		"firstname": "100",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "100", object.ID)
+}
+
+// TestMergeObjectsWithStrategy_InvalidWinner tests that an unknown winner ID is rejected before any request
+func TestMergeObjectsWithStrategy_InvalidWinner(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	defer server.Close()
+
+	_, err := objectClient.MergeObjectsWithStrategy(context.Background(), "contacts", "100", "200", map[string]string{
+		"firstname": "300",
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "firstname")
+}
+
 // TestMergeObjects_ValidationError tests validation error on merge
 func TestMergeObjects_ValidationError(t *testing.T) {
 	errorJSON := `{
@@ -728,6 +1776,108 @@ func TestBatchReadObjects_Success(t *testing.T) {
 	assert.Len(t, result.Results, 2)
 }
 
+// TestBatchReadObjects_WithPropertiesWithHistory tests that history is requested in the body and parsed into results
+func TestBatchReadObjects_WithPropertiesWithHistory(t *testing.T) {
+	responseJSON := `{
+		"completedAt": "2024-01-01T00:00:05.000Z",
+		"startedAt": "2024-01-01T00:00:00.000Z",
+		"status": "COMPLETE",
+		"results": [
+			{
+				"id": "1",
+				"properties": {"email": "new@example.com"},
+				"propertiesWithHistory": {
+					"email": [
+						{"value": "new@example.com", "timestamp": "2024-02-01T00:00:00.000Z", "sourceType": "CRM_UI", "sourceId": "userId:42", "updatedByUserId": 42},
+						{"value": "old@example.com", "timestamp": "2024-01-01T00:00:00.000Z", "sourceType": "IMPORT"}
+					],
+					"lifecyclestage": [
+						{"value": "lead", "timestamp": "2024-01-01T00:00:00.000Z", "sourceType": "API"}
+					]
+				},
+				"createdAt": "2024-01-01T00:00:00.000Z",
+				"updatedAt": "2024-02-01T00:00:00.000Z",
+				"archived": false
+			}
+		]
+	}`
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("propertiesWithHistory"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []any{"email", "lifecyclestage"}, body["propertiesWithHistory"])
+		assert.Equal(t, []any{"email"}, body["properties"])
+
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	input := &BatchReadObjectsInput{
+		Inputs: []struct {
+			ID string `json:"id" required:"yes"`
+		}{{ID: "1"}},
+		Properties:            []string{"email"},
+		PropertiesWithHistory: []string{"email"},
+	}
+
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input,
+		WithPropertiesWithHistory([]string{"lifecyclestage"}))
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	history := result.Results[0].PropertiesWithHistory
+	require.Len(t, history["email"], 2)
+	assert.Equal(t, "new@example.com", history["email"][0].Value)
+	assert.Equal(t, "CRM_UI", history["email"][0].SourceType)
+	assert.Equal(t, 42, history["email"][0].UpdatedByUserID)
+	assert.Equal(t, "old@example.com", history["email"][1].Value)
+	assert.Equal(t, "lead", history["lifecyclestage"][0].Value)
+	assert.Equal(t, []string{"email"}, input.PropertiesWithHistory)
+}
+
+// TestBatchReadObjects_Archived tests batch reading archived objects by ID
+func TestBatchReadObjects_Archived(t *testing.T) {
+	responseJSON := `{
+		"completedAt": "2024-01-01T00:00:05.000Z",
+		"startedAt": "2024-01-01T00:00:00.000Z",
+		"status": "COMPLETE",
+		"results": [
+			{
+				"id": "1",
+				"properties": {"email": "gone@example.com"},
+				"createdAt": "2023-01-01T00:00:00.000Z",
+				"updatedAt": "2024-01-01T00:00:00.000Z",
+				"archived": true,
+				"archivedAt": "2024-01-01T00:00:00.000Z"
+			}
+		]
+	}`
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/batch/read", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	input := &BatchReadObjectsInput{
+		Inputs: []struct {
+			ID string `json:"id" required:"yes"`
+		}{{ID: "1"}},
+		Properties:            []string{"email"},
+		PropertiesWithHistory: []string{},
+	}
+
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input, WithArchived())
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.True(t, result.Results[0].Archived)
+	assert.Equal(t, "2024-01-01T00:00:00.000Z", result.Results[0].ArchivedAt)
+}
+
 // TestBatchReadObjects_WithErrors tests batch read with errors
 func TestBatchReadObjects_WithErrors(t *testing.T) {
 	responseJSON := `{
@@ -763,50 +1913,276 @@ func TestBatchReadObjects_WithErrors(t *testing.T) {
 	}`
 
 	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
-		respondJSON(w, http.StatusOK, responseJSON)
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	input := &BatchReadObjectsInput{
+		Inputs: []struct {
+			ID string `json:"id" required:"yes"`
+		}{
+			{ID: "1"},
+			{ID: "99999"},
+		},
+		Properties:            []string{"email"},
+		PropertiesWithHistory: []string{},
+	}
+
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input)
+
+	require.Error(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 1, result.NumErrors)
+	assert.Len(t, result.Errors, 1)
+}
+
+// TestBatchReadObjects_InvalidJSON tests invalid JSON response
+func TestBatchReadObjects_InvalidJSON(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("invalid json"))
+	})
+	defer server.Close()
+
+	input := &BatchReadObjectsInput{
+		Inputs: []struct {
+			ID string `json:"id" required:"yes"`
+		}{{ID: "1"}},
+		Properties:            []string{"email"},
+		PropertiesWithHistory: []string{},
+	}
+
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to unmarshal")
+}
+
+// TestGetObjectsByIDProperty_Success tests batch lookup keyed on a unique property
+func TestGetObjectsByIDProperty_Success(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/contacts/batch/read", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "email", body["idProperty"])
+		assert.Equal(t, []any{
+			map[string]any{"id": "a@example.com"},
+			map[string]any{"id": "b@example.com"},
+		}, body["inputs"])
+		assert.Equal(t, []any{"email", "firstname"}, body["properties"])
+
+		respondJSON(w, http.StatusOK, `{
+			"status": "COMPLETE",
+			"results": [
+				{"id": "1", "properties": {"email": "a@example.com"}},
+				{"id": "2", "properties": {"email": "b@example.com"}}
+			],
+			"startedAt": "2024-01-01T00:00:00.000Z",
+			"completedAt": "2024-01-01T00:00:01.000Z"
+		}`)
+	})
+	defer server.Close()
+
+	objects, err := objectClient.GetObjectsByIDProperty(context.Background(), "contacts", "email",
+		[]string{"a@example.com", "b@example.com"}, []string{"email", "firstname"})
+
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "1", objects[0].ID)
+}
+
+// TestGetObjectsByIDProperty_PartialErrors tests that found objects are returned alongside errors
+func TestGetObjectsByIDProperty_PartialErrors(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusMultiStatus, `{
+			"status": "COMPLETE",
+			"results": [{"id": "1", "properties": {"email": "a@example.com"}}],
+			"numErrors": 1,
+			"errors": [{"status": "error", "category": "OBJECT_NOT_FOUND", "message": "Could not get some CONTACT objects"}]
+		}`)
+	})
+	defer server.Close()
+
+	objects, err := objectClient.GetObjectsByIDProperty(context.Background(), "contacts", "email",
+		[]string{"a@example.com", "missing@example.com"}, nil)
+
+	require.Error(t, err)
+	assert.Len(t, objects, 1)
+}
+
+// TestBatchReadObjects_WithOrderedResults tests reordering out-of-order results to input order
+func TestBatchReadObjects_WithOrderedResults(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusMultiStatus, `{
+			"status": "COMPLETE",
+			"results": [
+				{"id": "3", "properties": {}},
+				{"id": "1", "properties": {}}
+			],
+			"numErrors": 1,
+			"errors": [{"status": "error", "category": "OBJECT_NOT_FOUND", "message": "Could not get some objects"}]
+		}`)
 	})
 	defer server.Close()
 
-	input := &BatchReadObjectsInput{
-		Inputs: []struct {
+	input := &BatchReadObjectsInput{}
+	for _, id := range []string{"1", "2", "3"} {
+		input.Inputs = append(input.Inputs, struct {
 			ID string `json:"id" required:"yes"`
-		}{
-			{ID: "1"},
-			{ID: "99999"},
-		},
-		Properties:            []string{"email"},
-		PropertiesWithHistory: []string{},
+		}{ID: id})
 	}
 
-	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input)
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input, WithOrderedResults())
 
 	require.Error(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 1, result.NumErrors)
-	assert.Len(t, result.Errors, 1)
+	require.Len(t, result.Results, 3)
+	assert.Equal(t, "1", result.Results[0].ID)
+	assert.Empty(t, result.Results[1].ID, "errored input leaves a gap")
+	assert.Equal(t, "3", result.Results[2].ID)
 }
 
-// TestBatchReadObjects_InvalidJSON tests invalid JSON response
-func TestBatchReadObjects_InvalidJSON(t *testing.T) {
+// TestBatchReadObjectsOrdered_Success tests deduping, chunking and ordering a batch read by ID
+func TestBatchReadObjectsOrdered_Success(t *testing.T) {
+	var batchSizes []int
 	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("invalid json"))
+		assert.Equal(t, "/crm/v3/objects/contacts/batch/read", r.URL.Path)
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"email"}, body.Properties)
+		batchSizes = append(batchSizes, len(body.Inputs))
+
+		// Respond in reverse order and leave out ID 7
+		var results []string
+		for i := len(body.Inputs) - 1; i >= 0; i-- {
+			if id := body.Inputs[i].ID; id != "7" {
+				results = append(results, fmt.Sprintf(`{"id": %q, "properties": {}}`, id))
+			}
+		}
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [`+strings.Join(results, ",")+`]}`)
 	})
 	defer server.Close()
 
-	input := &BatchReadObjectsInput{
-		Inputs: []struct {
+	ids := []string{"7", "3", "1", "3"}
+	for i := 100; i < 200; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+
+	results, err := objectClient.BatchReadObjectsOrdered(context.Background(), "contacts", ids, []string{"email"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{100, 3}, batchSizes)
+	require.Len(t, results, len(ids))
+	assert.Nil(t, results[0], "missing ID is nil")
+	assert.Equal(t, "3", results[1].ID)
+	assert.Equal(t, "1", results[2].ID)
+	assert.Same(t, results[1], results[3], "duplicate IDs share one result")
+	assert.Equal(t, "199", results[len(ids)-1].ID)
+}
+
+// TestBatchReadObjects_IDPropertyRequested tests that the idProperty is read even when the caller didn't ask for it,
+// so ordered results can be matched to inputs
+func TestBatchReadObjects_IDPropertyRequested(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body BatchReadObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"firstname", "email"}, body.Properties)
+		respondJSON(w, http.StatusOK, `{
+			"status": "COMPLETE",
+			"results": [
+				{"id": "20", "properties": {"email": "b@example.com", "firstname": "B"}},
+				{"id": "10", "properties": {"email": "a@example.com", "firstname": "A"}}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	input := &BatchReadObjectsInput{IDProperty: "email", Properties: []string{"firstname"}}
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		input.Inputs = append(input.Inputs, struct {
 			ID string `json:"id" required:"yes"`
-		}{{ID: "1"}},
-		Properties:            []string{"email"},
-		PropertiesWithHistory: []string{},
+		}{ID: email})
 	}
 
-	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input)
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input, WithOrderedResults())
 
-	require.Error(t, err)
-	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "failed to unmarshal")
+	require.NoError(t, err)
+	assert.Equal(t, "10", result.Results[0].ID)
+	assert.Equal(t, "20", result.Results[1].ID)
+	assert.Equal(t, []string{"firstname"}, input.Properties, "input is not modified")
+}
+
+// TestBatchReadObjects_WithOrderedResultsIDProperty tests ordering by idProperty value
+func TestBatchReadObjects_WithOrderedResultsIDProperty(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{
+			"status": "COMPLETE",
+			"results": [
+				{"id": "20", "properties": {"email": "b@example.com"}},
+				{"id": "10", "properties": {"email": "a@example.com"}}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	objects, err := objectClient.GetObjectsByIDProperty(context.Background(), "contacts", "email",
+		[]string{"a@example.com", "b@example.com"}, []string{"email"})
+	require.NoError(t, err)
+	assert.Equal(t, "20", objects[0].ID, "unordered by default")
+
+	input := &BatchReadObjectsInput{IDProperty: "email"}
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		input.Inputs = append(input.Inputs, struct {
+			ID string `json:"id" required:"yes"`
+		}{ID: email})
+	}
+
+	result, err := objectClient.BatchReadObjects(context.Background(), "contacts", input, WithOrderedResults())
+
+	require.NoError(t, err)
+	assert.Equal(t, "10", result.Results[0].ID)
+	assert.Equal(t, "20", result.Results[1].ID)
+}
+
+// TestBatchCreateObjects_WithOrderedResults tests ordering created objects by write trace ID
+func TestBatchCreateObjects_WithOrderedResults(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body BatchCreateObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Inputs, 2)
+		assert.Equal(t, "0", body.Inputs[0].ObjectWriteTraceID)
+		assert.Equal(t, "custom", body.Inputs[1].ObjectWriteTraceID)
+
+		respondJSON(w, http.StatusCreated, `{
+			"status": "COMPLETE",
+			"results": [
+				{"id": "200", "properties": {}, "objectWriteTraceId": "custom"},
+				{"id": "100", "properties": {}, "objectWriteTraceId": "0"}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	input := &BatchCreateObjectsInput{}
+	input.Inputs = append(input.Inputs, struct {
+		Associations       []Association     `json:"associations" required:"yes"`
+		Properties         map[string]string `json:"properties" required:"yes"`
+		ObjectWriteTraceID string            `json:"objectWriteTraceId"`
+	}{Properties: map[string]string{"email": "a@example.com"}}, struct {
+		Associations       []Association     `json:"associations" required:"yes"`
+		Properties         map[string]string `json:"properties" required:"yes"`
+		ObjectWriteTraceID string            `json:"objectWriteTraceId"`
+	}{Properties: map[string]string{"email": "b@example.com"}, ObjectWriteTraceID: "custom"})
+
+	result, err := objectClient.BatchCreateObjects(context.Background(), "contacts", input, WithOrderedResults())
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "100", result.Results[0].ID)
+	assert.Equal(t, "200", result.Results[1].ID)
+	assert.Empty(t, input.Inputs[0].ObjectWriteTraceID, "caller input must not be modified")
 }
 
 // TestBatchCreateObjects_Success tests successful batch create
@@ -1597,3 +2973,581 @@ func TestSearchObjects_InvalidJSON(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to unmarshal")
 }
+
+// TestSearchObjects_InvalidFilter tests that a filter missing its values is rejected without a request
+func TestSearchObjects_InvalidFilter(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+	defer server.Close()
+
+	input := &SearchObjectsInput{
+		Limit:      10,
+		Sorts:      []string{},
+		Properties: []string{"email"},
+		FilterGroups: []SearchFilterGroup{
+			{Filters: []SearchFilter{{PropertyName: "lifecyclestage", Operator: In}}},
+		},
+	}
+
+	result, err := objectClient.SearchObjects(context.Background(), "contacts", input)
+
+	var validationErr *SearchValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "lifecyclestage", validationErr.PropertyName)
+	assert.Nil(t, result)
+}
+
+// archiveMatchingServer serves a two-page search for ids and records the IDs sent to batch archive
+func archiveMatchingServer(t *testing.T, ids []string, archived *[][]string) (*httptest.Server, *Client) {
+	return setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/objects/contacts/search":
+			var body SearchObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "test", body.FilterGroups[0].Filters[0].Value)
+
+			start, end, next := 0, 200, `{"next": {"after": "200"}}`
+			if body.After == "200" {
+				start, end, next = 200, len(ids), `{}`
+			}
+			results := make([]string, 0, end-start)
+			for _, id := range ids[start:end] {
+				results = append(results, fmt.Sprintf(`{"id": %q, "properties": {}}`, id))
+			}
+			respondJSON(w, http.StatusOK, fmt.Sprintf(`{"total": %d, "results": [%s], "paging": %s}`,
+				len(ids), strings.Join(results, ","), next))
+		case "/crm/v3/objects/contacts/batch/archive":
+			var body BatchArchiveObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			batch := make([]string, 0, len(body.Inputs))
+			for _, input := range body.Inputs {
+				batch = append(batch, input.ID)
+			}
+			*archived = append(*archived, batch)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+}
+
+// testDataSearch is a search for objects flagged as test data
+func testDataSearch() *SearchObjectsInput {
+	return &SearchObjectsInput{
+		FilterGroups: []SearchFilterGroup{{
+			Filters: []SearchFilter{{PropertyName: "source", Operator: EQ, Value: "test"}},
+		}},
+	}
+}
+
+// TestArchiveMatching_DryRun tests that a dry run collects every matching ID without archiving any
+func TestArchiveMatching_DryRun(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+	var archived [][]string
+	server, objectClient := archiveMatchingServer(t, ids, &archived)
+	defer server.Close()
+
+	result, err := objectClient.ArchiveMatching(context.Background(), "contacts", testDataSearch(), ArchiveDryRun)
+
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, ids, result.IDs)
+	assert.Zero(t, result.Archived)
+	assert.Empty(t, archived)
+}
+
+// TestArchiveMatching_Confirmed tests that matching objects are archived in batches of 100
+func TestArchiveMatching_Confirmed(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+	var archived [][]string
+	server, objectClient := archiveMatchingServer(t, ids, &archived)
+	defer server.Close()
+
+	result, err := objectClient.ArchiveMatching(context.Background(), "contacts", testDataSearch(), ArchiveConfirmed)
+
+	require.NoError(t, err)
+	assert.False(t, result.DryRun)
+	assert.Equal(t, 250, result.Archived)
+	require.Len(t, archived, 3)
+	assert.Equal(t, ids[:100], archived[0])
+	assert.Equal(t, ids[100:200], archived[1])
+	assert.Equal(t, ids[200:], archived[2])
+}
+
+// TestArchiveMatching_TooManyResults tests that nothing is archived when the search exceeds the 10k result cap
+func TestArchiveMatching_TooManyResults(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/contacts/search", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"total": 10001, "results": [{"id": "1"}], "paging": {"next": {"after": "1"}}}`)
+	})
+	defer server.Close()
+
+	result, err := objectClient.ArchiveMatching(context.Background(), "contacts", testDataSearch(), ArchiveConfirmed)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "10001")
+}
+
+// TestExportViaSearch_Success tests exporting objects across search pages
+func TestExportViaSearch_Success(t *testing.T) {
+	requests := 0
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/contacts/search", r.URL.Path)
+
+		var body SearchObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"hs_object_id"}, body.Sorts)
+		assert.Equal(t, []string{"hs_object_id"}, body.Properties)
+		assert.Empty(t, body.FilterGroups)
+
+		requests++
+		switch body.After {
+		case "":
+			respondJSON(w, http.StatusOK, `{
+				"total": 2,
+				"results": [{"id": "1", "properties": {"hs_object_id": "1"}}],
+				"paging": {"next": {"after": "1"}}
+			}`)
+		case "1":
+			respondJSON(w, http.StatusOK, `{
+				"total": 2,
+				"results": [{"id": "2", "properties": {"hs_object_id": "2"}}]
+			}`)
+		default:
+			t.Fatalf("unexpected after cursor %q", body.After)
+		}
+	})
+	defer server.Close()
+
+	var ids []string
+	err := objectClient.ExportViaSearch(context.Background(), "contacts", "hs_object_id", func(obj *Object) error {
+		ids = append(ids, obj.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, 2, requests)
+}
+
+// TestExportViaSearch_ReAnchors tests that hitting the 10k cap re-anchors on the last seen value
+func TestExportViaSearch_ReAnchors(t *testing.T) {
+	var bodies []SearchObjectsInput
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body SearchObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+
+		switch len(bodies) {
+		case 1:
+			respondJSON(w, http.StatusOK, `{
+				"total": 20000,
+				"results": [{"id": "10000", "properties": {"hs_object_id": "10000"}}],
+				"paging": {"next": {"after": "10000"}}
+			}`)
+		case 2:
+			respondJSON(w, http.StatusOK, `{
+				"total": 1,
+				"results": [{"id": "10001", "properties": {"hs_object_id": "10001"}}],
+				"paging": {"next": {"after": "200"}}
+			}`)
+		default:
+			respondJSON(w, http.StatusOK, `{"total": 0, "results": []}`)
+		}
+	})
+	defer server.Close()
+
+	var ids []string
+	err := objectClient.ExportViaSearch(context.Background(), "contacts", "hs_object_id", func(obj *Object) error {
+		ids = append(ids, obj.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10000", "10001"}, ids)
+	require.Len(t, bodies, 3)
+
+	assert.Empty(t, bodies[1].After)
+	require.Len(t, bodies[1].FilterGroups, 1)
+	filter := bodies[1].FilterGroups[0].Filters[0]
+	assert.Equal(t, "hs_object_id", filter.PropertyName)
+	assert.Equal(t, GTE, filter.Operator)
+	assert.Equal(t, "10000", filter.Value)
+
+	// The anchor filter is kept while paging the re-anchored query
+	assert.Equal(t, "200", bodies[2].After)
+	require.Len(t, bodies[2].FilterGroups, 1)
+	assert.Equal(t, "10000", bodies[2].FilterGroups[0].Filters[0].Value)
+}
+
+// TestExportViaSearch_SharedAnchorValue tests that objects sharing the anchor value are exported once each when the
+// query is re-anchored, and that the caller's properties are requested
+func TestExportViaSearch_SharedAnchorValue(t *testing.T) {
+	var bodies []SearchObjectsInput
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body SearchObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+
+		switch len(bodies) {
+		case 1:
+			respondJSON(w, http.StatusOK, `{
+				"results": [
+					{"id": "1", "properties": {"createdate": "2024-01-01", "email": "a@example.com"}},
+					{"id": "2", "properties": {"createdate": "2024-01-02", "email": "b@example.com"}}
+				],
+				"paging": {"next": {"after": "10000"}}
+			}`)
+		case 2:
+			// Objects 2 and 3 share the anchor value; 2 was already exported
+			respondJSON(w, http.StatusOK, `{
+				"results": [
+					{"id": "3", "properties": {"createdate": "2024-01-02", "email": "c@example.com"}},
+					{"id": "2", "properties": {"createdate": "2024-01-02", "email": "b@example.com"}},
+					{"id": "4", "properties": {"createdate": "2024-01-03", "email": "d@example.com"}}
+				]
+			}`)
+		default:
+			t.Fatalf("unexpected request %d", len(bodies))
+		}
+	})
+	defer server.Close()
+
+	var emails []string
+	err := objectClient.ExportViaSearch(context.Background(), "contacts", "createdate", func(obj *Object) error {
+		emails = append(emails, obj.Properties["email"])
+		return nil
+	}, WithProperties([]string{"email"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"}, emails)
+	assert.Equal(t, []string{"email", "createdate"}, bodies[0].Properties)
+	require.Len(t, bodies, 2)
+	assert.Equal(t, GTE, bodies[1].FilterGroups[0].Filters[0].Operator)
+	assert.Equal(t, "2024-01-02", bodies[1].FilterGroups[0].Filters[0].Value)
+}
+
+// TestExportViaSearch_AnchorValueTooCommon tests that a window holding only the anchor value stops with an error
+func TestExportViaSearch_AnchorValueTooCommon(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{
+			"results": [{"id": "1", "properties": {"createdate": "2024-01-01"}}],
+			"paging": {"next": {"after": "10000"}}
+		}`)
+	})
+	defer server.Close()
+
+	err := objectClient.ExportViaSearch(context.Background(), "contacts", "createdate", func(obj *Object) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "more than 10000")
+}
+
+// TestExportViaSearch_CallbackError tests that a callback error stops the export
+func TestExportViaSearch_CallbackError(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{
+			"total": 2,
+			"results": [{"id": "1", "properties": {}}, {"id": "2", "properties": {}}],
+			"paging": {"next": {"after": "2"}}
+		}`)
+	})
+	defer server.Close()
+
+	calls := 0
+	stop := errors.New("stop")
+	err := objectClient.ExportViaSearch(context.Background(), "contacts", "hs_object_id", func(obj *Object) error {
+		calls++
+		return stop
+	})
+
+	require.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+// TestFindByProperty_OneMatch tests finding a single object by property value
+func TestFindByProperty_OneMatch(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/contacts/search", r.URL.Path)
+
+		var body SearchObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.FilterGroups, 1)
+		require.Len(t, body.FilterGroups[0].Filters, 1)
+		filter := body.FilterGroups[0].Filters[0]
+		assert.Equal(t, "email", filter.PropertyName)
+		assert.Equal(t, EQ, filter.Operator)
+		assert.Equal(t, "jane@example.com", filter.Value)
+		assert.Equal(t, []string{"email", "firstname"}, body.Properties)
+
+		respondJSON(w, http.StatusOK, `{
+			"total": 1,
+			"results": [{"id": "101", "properties": {"email": "jane@example.com", "firstname": "Jane"}, "archived": false}]
+		}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.FindByProperty(context.Background(), "contacts", "email", "jane@example.com", []string{"email", "firstname"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "101", object.ID)
+	assert.Equal(t, "Jane", object.Properties["firstname"])
+}
+
+// TestFindByProperty_NoMatch tests the typed not-found error
+func TestFindByProperty_NoMatch(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"total": 0, "results": []}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.FindByProperty(context.Background(), "contacts", "email", "nobody@example.com", nil)
+
+	assert.Nil(t, object)
+	var notFound *ObjectNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "contacts", notFound.ObjectType)
+}
+
+// TestFindByProperty_MultipleMatches tests that a non-unique value is an error
+func TestFindByProperty_MultipleMatches(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{
+			"total": 3,
+			"results": [
+				{"id": "101", "properties": {"company": "Acme"}, "archived": false},
+				{"id": "102", "properties": {"company": "Acme"}, "archived": false}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	object, err := objectClient.FindByProperty(context.Background(), "contacts", "company", "Acme", nil)
+
+	assert.Nil(t, object)
+	var multiple *MultipleObjectsFoundError
+	require.ErrorAs(t, err, &multiple)
+	assert.Equal(t, 3, multiple.Count)
+	assert.Equal(t, "company", multiple.PropertyName)
+}
+
+// TestSearchByProperty tests the single-filter search shortcut
+func TestSearchByProperty(t *testing.T) {
+	t.Run("Equality with options", func(t *testing.T) {
+		server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v3/objects/contacts/search", r.URL.Path)
+			assert.Empty(t, r.URL.RawQuery)
+
+			var body SearchObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []SearchFilterGroup{{Filters: []SearchFilter{{
+				PropertyName: "email",
+				Operator:     EQ,
+				Value:        "jane@example.com",
+			}}}}, body.FilterGroups)
+			assert.Equal(t, []string{"email", "firstname"}, body.Properties)
+			assert.Equal(t, 10, body.Limit)
+			assert.Equal(t, "20", body.After)
+
+			respondJSON(w, http.StatusOK, `{"total": 1, "results": [{"id": "101", "properties": {"email": "jane@example.com"}, "archived": false}]}`)
+		})
+		defer server.Close()
+
+		resp, err := objectClient.SearchByProperty(context.Background(), "contacts", "email", EQ, "jane@example.com",
+			WithProperties([]string{"email", "firstname"}), WithLimit(10), WithAfter("20"))
+
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, "101", resp.Results[0].ID)
+	})
+
+	t.Run("In splits values", func(t *testing.T) {
+		server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var body SearchObjectsInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			filter := body.FilterGroups[0].Filters[0]
+			assert.Equal(t, In, filter.Operator)
+			assert.Equal(t, []string{"lead", "customer"}, filter.Values)
+			assert.Empty(t, filter.Value)
+
+			respondJSON(w, http.StatusOK, `{"total": 0, "results": []}`)
+		})
+		defer server.Close()
+
+		resp, err := objectClient.SearchByProperty(context.Background(), "contacts", "lifecyclestage", In, "lead,customer")
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Results)
+	})
+
+	t.Run("Between is rejected", func(t *testing.T) {
+		_, err := NewClient(nil).SearchByProperty(context.Background(), "contacts", "amount", Between, "10")
+		assert.Error(t, err)
+	})
+}
+
+// TestCountObjects tests that counting sends a minimal search and returns the total, including zero
+func TestCountObjects(t *testing.T) {
+	total := 87
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/deals/search", r.URL.Path)
+
+		var body SearchObjectsInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 1, body.Limit)
+		assert.Empty(t, body.After)
+		assert.Equal(t, []string{"hs_object_id"}, body.Properties)
+		require.Len(t, body.FilterGroups, 1)
+
+		if total == 0 {
+			respondJSON(w, http.StatusOK, `{"total": 0, "results": []}`)
+			return
+		}
+		respondJSON(w, http.StatusOK, `{"total": 87, "results": [{"id": "1", "properties": {}, "archived": false}]}`)
+	})
+	defer server.Close()
+
+	input := &SearchObjectsInput{
+		Limit:      50,
+		After:      "100",
+		Properties: []string{"dealname", "amount"},
+		FilterGroups: []SearchFilterGroup{{Filters: []SearchFilter{{
+			PropertyName: "dealstage",
+			Operator:     EQ,
+			Value:        "closedwon",
+		}}}},
+	}
+
+	count, err := objectClient.CountObjects(context.Background(), "deals", input)
+	require.NoError(t, err)
+	assert.Equal(t, 87, count)
+	assert.Equal(t, 50, input.Limit, "the caller's input is not modified")
+
+	total = 0
+	count, err = objectClient.CountObjects(context.Background(), "deals", input)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+// TestObject_History tests the property history accessors
+func TestObject_History(t *testing.T) {
+	objectJSON := `{
+		"id": "101",
+		"properties": {"email": "new@example.com"},
+		"propertiesWithHistory": {
+			"email": [
+				{"value": "api@example.com", "timestamp": "2024-02-01T00:00:00Z", "sourceType": "API", "sourceId": "app-1"},
+				{"value": "new@example.com", "timestamp": "2024-03-01T10:30:00.5Z", "sourceType": "CRM_UI", "updatedByUserId": 7},
+				{"value": "old@example.com", "timestamp": "2024-01-01T00:00:00Z", "sourceType": "CRM_UI", "updatedByUserId": 3}
+			]
+		}
+	}`
+
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "email", r.URL.Query().Get("propertiesWithHistory"))
+		respondJSON(w, http.StatusOK, objectJSON)
+	})
+	defer server.Close()
+
+	obj, err := objectClient.ReadObject(context.Background(), "contacts", "101", WithPropertiesWithHistory([]string{"email"}))
+	require.NoError(t, err)
+
+	latest, ok := obj.LatestHistory("email")
+	require.True(t, ok)
+	assert.Equal(t, "new@example.com", latest.Value)
+	assert.Equal(t, 7, latest.UpdatedByUserID)
+
+	_, ok = obj.LatestHistory("phone")
+	assert.False(t, ok)
+
+	fromUI := obj.HistoryBySource("email", "CRM_UI")
+	require.Len(t, fromUI, 2)
+	assert.Equal(t, "new@example.com", fromUI[0].Value)
+	assert.Equal(t, "old@example.com", fromUI[1].Value)
+
+	assert.Len(t, obj.HistoryBySource("email", "API"), 1)
+	assert.Empty(t, obj.HistoryBySource("email", "INTEGRATION"))
+
+	fromApp := obj.HistoryBySourceID("email", "app-1")
+	require.Len(t, fromApp, 1)
+	assert.Equal(t, "api@example.com", fromApp[0].Value)
+	assert.Empty(t, obj.HistoryBySourceID("email", "app-2"))
+}
+
+// TestReadObject_AssociationLimit tests that cut off inline associations are paged up to the limit
+func TestReadObject_AssociationLimit(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/objects/contacts/101":
+			assert.Equal(t, "companies,deals", r.URL.Query().Get("associations"))
+			respondJSON(w, http.StatusOK, `{"id": "101", "properties": {}, "associations": {
+				"companies": {"results": [{"id": "1", "type": "contact_to_company"}], "paging": {"next": {"after": "c1"}}},
+				"deals": {"results": [{"id": "7", "type": "contact_to_deal"}, {"id": "8", "type": "contact_to_deal"}, {"id": "9", "type": "contact_to_deal"}]}
+			}}`)
+		case "/crm/v3/objects/contacts/101/associations/companies":
+			switch r.URL.Query().Get("after") {
+			case "c1":
+				assert.Equal(t, "2", r.URL.Query().Get("limit"))
+				respondJSON(w, http.StatusOK, `{"results": [{"id": "2", "type": "contact_to_company"}], "paging": {"next": {"after": "c2"}}}`)
+			case "c2":
+				assert.Equal(t, "1", r.URL.Query().Get("limit"))
+				respondJSON(w, http.StatusOK, `{"results": [{"id": "3", "type": "contact_to_company"}], "paging": {"next": {"after": "c3"}}}`)
+			default:
+				t.Errorf("unexpected cursor %q", r.URL.Query().Get("after"))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	obj, err := objectClient.ReadObjectWithAssociations(context.Background(), "contacts", "101", []string{"companies", "deals"}, WithAssociationLimit(3))
+	require.NoError(t, err)
+
+	ids := func(results []AssociationResult) []string {
+		var out []string
+		for _, result := range results {
+			out = append(out, result.ID)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids(obj.Associations["companies"].Results))
+	assert.Equal(t, "c3", obj.Associations["companies"].Paging.Next.After)
+	assert.Equal(t, []string{"7", "8", "9"}, ids(obj.Associations["deals"].Results))
+}
+
+// TestReadObject_AssociationLimitRepeatedCursor tests that association paging stops when a page repeats its cursor
+func TestReadObject_AssociationLimitRepeatedCursor(t *testing.T) {
+	server, objectClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v3/objects/contacts/101":
+			respondJSON(w, http.StatusOK, `{"id": "101", "properties": {}, "associations": {
+				"companies": {"results": [{"id": "1", "type": "contact_to_company"}], "paging": {"next": {"after": "c1"}}}
+			}}`)
+		default:
+			respondJSON(w, http.StatusOK, `{"results": [], "paging": {"next": {"after": "c1"}}}`)
+		}
+	})
+	defer server.Close()
+
+	_, err := objectClient.ReadObjectWithAssociations(context.Background(), "contacts", "101", []string{"companies"}, WithAssociationLimit(10))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, associations.ErrTooManyPages)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
@@ -1,19 +1,34 @@
 package objects
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
 )
 
+// ErrNoObjectsFound is returned by ListObjects when a page has no results, unless WithAllowEmpty is passed
+var ErrNoObjectsFound = errors.New("no objects found")
+
 // ObjectNotFoundError is returned when an object is not found
+//
+// ObjectID is set by the single-read methods; it is empty when the object was looked up some other way
 type ObjectNotFoundError struct {
 	ObjectType string
+	ObjectID   string
 	Original   *client.HubSpotError
 }
 
 func (e *ObjectNotFoundError) Error() string {
-	return fmt.Sprintf("object %s not found", e.ObjectType)
+	if e.ObjectID == "" {
+		return fmt.Sprintf("object %s not found", e.ObjectType)
+	}
+	return fmt.Sprintf("object %s %s not found", e.ObjectType, e.ObjectID)
+}
+
+// Unwrap returns the error as a *client.NotFoundError, which in turn unwraps to the HubSpot error it was built from
+func (e *ObjectNotFoundError) Unwrap() error {
+	return &client.NotFoundError{ObjectType: e.ObjectType, ObjectID: e.ObjectID, Original: e.Original}
 }
 
 type ObjectValidationError struct {
@@ -26,6 +41,14 @@ func (e *ObjectValidationError) Error() string {
 	return fmt.Sprintf("validation error on field %s: %s", e.Field, e.Message)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ObjectValidationError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
 type ObjectAlreadyExistsError struct {
 	ObjectID string
 	Original *client.HubSpotError
@@ -35,6 +58,65 @@ func (e *ObjectAlreadyExistsError) Error() string {
 	return fmt.Sprintf("object with id %s already exists", e.ObjectID)
 }
 
+// Unwrap returns the HubSpot error this error was built from
+func (e *ObjectAlreadyExistsError) Unwrap() error {
+	if e.Original == nil {
+		return nil
+	}
+	return e.Original
+}
+
+// AssociationCreateError is returned when an object was updated but adding one of its associations failed
+type AssociationCreateError struct {
+	ObjectID     string
+	ToObjectType string
+	ToObjectID   string
+	Err          error
+}
+
+func (e *AssociationCreateError) Error() string {
+	return fmt.Sprintf("object %s was updated but associating it with %s %s failed: %v", e.ObjectID, e.ToObjectType, e.ToObjectID, e.Err)
+}
+
+func (e *AssociationCreateError) Unwrap() error {
+	return e.Err
+}
+
+// MultipleObjectsFoundError is returned by FindByProperty when more than one object has the searched value
+type MultipleObjectsFoundError struct {
+	ObjectType   string
+	PropertyName string
+	Value        string
+	Count        int
+}
+
+func (e *MultipleObjectsFoundError) Error() string {
+	return fmt.Sprintf("%d %s found with %s %q, expected one", e.Count, e.ObjectType, e.PropertyName, e.Value)
+}
+
+// SearchValidationError is returned without sending the request when a search filter's operator and values don't
+// match, e.g. BETWEEN without a HighValue or IN without Values
+type SearchValidationError struct {
+	FilterGroup  int // Index of the filter group in FilterGroups
+	Filter       int // Index of the filter in its group
+	PropertyName string
+	Operator     FilterOperator
+	Message      string
+}
+
+func (e *SearchValidationError) Error() string {
+	return fmt.Sprintf("invalid filter %d of filter group %d on property %s: operator %s %s", e.Filter, e.FilterGroup, e.PropertyName, e.Operator, e.Message)
+}
+
+// parseReadError is ParseObjectError for a read of the object with id, which it sets on not-found errors
+func parseReadError(err error, objectType, id string) error {
+	err = ParseObjectError(err, objectType)
+	if notFound, ok := err.(*ObjectNotFoundError); ok {
+		notFound.ObjectID = id
+	}
+	return err
+}
+
 func ParseObjectError(err error, objectType string) error {
 	if hubspotErr, ok := err.(*client.HubSpotError); ok {
 		switch hubspotErr.Status {
@@ -44,7 +126,7 @@ func ParseObjectError(err error, objectType string) error {
 				Original:   hubspotErr,
 			}
 		case 400:
-			if hubspotErr.Category == "VALIDATION_ERROR" {
+			if hubspotErr.Category == client.CategoryValidation {
 				return &ObjectValidationError{
 					Field:    hubspotErr.Message,
 					Original: hubspotErr,
@@ -250,3 +250,75 @@ func TestBatchError_Error_EmptyMessageNoErrors(t *testing.T) {
 	expectedMsg := "batch error"
 	assert.Equal(t, expectedMsg, err.Error())
 }
+
+// TestParseObjectError_Unwrap tests that typed errors unwrap to the HubSpotError they were built from
+func TestParseObjectError_Unwrap(t *testing.T) {
+	original := &client.HubSpotError{Status: 404, Category: client.CategoryObjectNotFound}
+
+	err := ParseObjectError(original, "contacts")
+
+	var notFound *ObjectNotFoundError
+	require.ErrorAs(t, err, &notFound)
+
+	hubspotErr, ok := client.AsHubSpotError(err)
+	require.True(t, ok)
+	assert.Same(t, original, hubspotErr)
+	assert.True(t, hubspotErr.IsNotFound())
+
+	// Client-side validation errors have no HubSpot error to unwrap to
+	_, ok = client.AsHubSpotError(&ObjectValidationError{Field: "email"})
+	assert.False(t, ok)
+}
+
+// TestSearchValidationError_Error tests the Error() method
+func TestSearchValidationError_Error(t *testing.T) {
+	err := &SearchValidationError{
+		FilterGroup:  1,
+		Filter:       0,
+		PropertyName: "amount",
+		Operator:     Between,
+		Message:      "requires Value and HighValue",
+	}
+
+	assert.Equal(t, "invalid filter 0 of filter group 1 on property amount: operator BETWEEN requires Value and HighValue", err.Error())
+}
+
+// TestValidateSearchFilters tests operator-specific search filter validation
+func TestValidateSearchFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  SearchFilter
+		wantErr bool
+	}{
+		{"EQ with value", SearchFilter{PropertyName: "email", Operator: EQ, Value: "a@example.com"}, false},
+		{"BETWEEN with both values", SearchFilter{PropertyName: "amount", Operator: Between, Value: "1", HighValue: "10"}, false},
+		{"BETWEEN without high value", SearchFilter{PropertyName: "amount", Operator: Between, Value: "1"}, true},
+		{"IN with values", SearchFilter{PropertyName: "stage", Operator: In, Values: []string{"a", "b"}}, false},
+		{"IN without values", SearchFilter{PropertyName: "stage", Operator: In}, true},
+		{"NOT_IN without values", SearchFilter{PropertyName: "stage", Operator: NotIn}, true},
+		{"HAS_PROPERTY", SearchFilter{PropertyName: "email", Operator: HasProperty}, false},
+		{"HAS_PROPERTY with value", SearchFilter{PropertyName: "email", Operator: HasProperty, Value: "x"}, true},
+		{"NOT_HAS_PROPERTY with values", SearchFilter{PropertyName: "email", Operator: NotHasProperty, Values: []string{"x"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := []SearchFilterGroup{
+				{Filters: []SearchFilter{{PropertyName: "email", Operator: HasProperty}}},
+				{Filters: []SearchFilter{{PropertyName: "firstname", Operator: EQ, Value: "Ada"}, tt.filter}},
+			}
+
+			err := validateSearchFilters(groups)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *SearchValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, 1, validationErr.FilterGroup)
+			assert.Equal(t, 1, validationErr.Filter)
+			assert.Equal(t, tt.filter.PropertyName, validationErr.PropertyName)
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package objects
 
-import "strings"
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v4/associations"
+)
 
 type AssociationCategory string
 
@@ -67,17 +73,32 @@ type Association struct {
 	} `json:"types"`
 	To struct {
 		ID string `json:"id"`
-	} `json:"id"`
+	} `json:"to"`
+}
+
+// AssociationToAdd is an association created alongside an update by UpdateObjectWithAssociations
+type AssociationToAdd struct {
+	ToObjectType string
+	ToObjectID   string
+	Types        []associations.AssociationSpec
 }
 
 type AssociationResponse struct {
-	Results struct {
-		ID   string `json:"id"`
-		Type string `json:"type"`
-	} `json:"results"`
-	Paging Paging `json:"paging"`
+	Results []AssociationResult `json:"results"`
+	Paging  Paging              `json:"paging"`
 }
 
+// AssociationResult is a single associated object returned inline with an object
+type AssociationResult struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// PropertyWithHistory is one recorded value of a property
+//
+// HubSpot attributes each write itself: SourceType is e.g. "API" or "INTEGRATION" and SourceID identifies the app
+// whose token made the write. Create and update requests have no field to set the source, so integrations sharing a
+// portal are told apart by authenticating each with its own app and matching on SourceID with HistoryBySourceID.
 type PropertyWithHistory struct {
 	SourceType      string `json:"sourceType"`
 	Value           string `json:"value"`
@@ -87,13 +108,78 @@ type PropertyWithHistory struct {
 	UpdatedByUserID int    `json:"updatedByUserId"`
 }
 
+// LatestHistory returns the most recent history entry of property
+//
+// The object must have been read with WithPropertiesWithHistory for property; otherwise, or when the property has no
+// history, ok is false
+func (o *Object) LatestHistory(property string) (*PropertyWithHistory, bool) {
+	history := o.PropertiesWithHistory[property]
+	if len(history) == 0 {
+		return nil, false
+	}
+
+	latest := &history[0]
+	for i := range history[1:] {
+		if historyAfter(history[i+1], *latest) {
+			latest = &history[i+1]
+		}
+	}
+	return latest, true
+}
+
+// HistoryBySource returns the history entries of property whose SourceType is sourceType, e.g. "API", "CRM_UI" or
+// "INTEGRATION", newest first
+func (o *Object) HistoryBySource(property, sourceType string) []PropertyWithHistory {
+	return o.historyWhere(property, func(entry PropertyWithHistory) bool {
+		return entry.SourceType == sourceType
+	})
+}
+
+// HistoryBySourceID returns the history entries of property written by sourceID, such as an app ID, newest first
+func (o *Object) HistoryBySourceID(property, sourceID string) []PropertyWithHistory {
+	return o.historyWhere(property, func(entry PropertyWithHistory) bool {
+		return entry.SourceID == sourceID
+	})
+}
+
+// historyWhere returns the history entries of property for which match is true, newest first
+func (o *Object) historyWhere(property string, match func(PropertyWithHistory) bool) []PropertyWithHistory {
+	var matches []PropertyWithHistory
+	for _, entry := range o.PropertiesWithHistory[property] {
+		if match(entry) {
+			matches = append(matches, entry)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b PropertyWithHistory) int {
+		switch {
+		case historyAfter(a, b):
+			return -1
+		case historyAfter(b, a):
+			return 1
+		}
+		return 0
+	})
+	return matches
+}
+
+// historyAfter reports whether a was recorded after b, comparing timestamps as strings if either can't be parsed
+func historyAfter(a, b PropertyWithHistory) bool {
+	aTime, aErr := time.Parse(time.RFC3339Nano, a.Timestamp)
+	bTime, bErr := time.Parse(time.RFC3339Nano, b.Timestamp)
+	if aErr != nil || bErr != nil {
+		return a.Timestamp > b.Timestamp
+	}
+	return aTime.After(bTime)
+}
+
 type ListObjectsResponse struct {
 	Results []Object `json:"results"`
 	Paging  Paging   `json:"paging"`
 }
 
 type CreateObjectInput struct {
-	Associations []Association     `json:"associations" required:"yes"`
+	Associations []Association     `json:"associations"`
 	Properties   map[string]string `json:"properties" required:"yes"`
 }
 
@@ -199,7 +285,7 @@ type BatchArchiveObjectsInput struct {
 type BatchResponse struct {
 	CompletedAt string            `json:"completedAt" required:"yes"`
 	StartedAt   string            `json:"startedAt" required:"yes"`
-	Results     []Object          `json:"results" required:"yes"`
+	Results     []Object          `json:"results" required:"yes"` // Not in input order unless WithOrderedResults is used
 	Status      BatchStatus       `json:"status" required:"yes"`
 	NumErrors   int               `json:"numErrors"`
 	RequestedAt string            `json:"requestedAt"`
@@ -207,21 +293,44 @@ type BatchResponse struct {
 	Errors      []BatchError      `json:"errors"`
 }
 
+// SearchFilter is a single property filter within a SearchFilterGroup
+type SearchFilter = struct {
+	PropertyName string         `json:"propertyName" required:"yes"`
+	Operator     FilterOperator `json:"operator" required:"yes"`
+	HighValue    string         `json:"highValue"`
+	Values       []string       `json:"values"`
+	Value        string         `json:"value"`
+}
+
+// SearchFilterGroup is a group of filters that are ANDed together
+type SearchFilterGroup = struct {
+	Filters []SearchFilter `json:"filters" required:"yes"`
+}
+
 type SearchObjectsInput struct {
-	Limit        int      `json:"limit" required:"yes"`
-	After        string   `json:"after" required:"yes"`
-	Sorts        []string `json:"sorts" required:"yes"`
-	Properties   []string `json:"properties" required:"yes"`
-	FilterGroups []struct {
-		Filters []struct {
-			PropertyName string         `json:"propertyName" required:"yes"`
-			Operator     FilterOperator `json:"operator" required:"yes"`
-			HighValue    string         `json:"highValue"`
-			Values       []string       `json:"values"`
-			Value        string         `json:"value"`
-		} `json:"filters" required:"yes"`
-	} `json:"filterGroups" required:"yes"`
-	Query string `json:"query"`
+	Limit        int                 `json:"limit" required:"yes"`
+	After        string              `json:"after" required:"yes"`
+	Sorts        []string            `json:"sorts" required:"yes"`
+	Properties   []string            `json:"properties" required:"yes"`
+	FilterGroups []SearchFilterGroup `json:"filterGroups" required:"yes"`
+	Query        string              `json:"query"`
+}
+
+// ArchiveMatchingMode controls whether ArchiveMatching archives the objects it finds
+type ArchiveMatchingMode int
+
+const (
+	// ArchiveDryRun only lists the IDs that would be archived; it is the zero value, so archiving must be opted into
+	ArchiveDryRun ArchiveMatchingMode = iota
+	// ArchiveConfirmed archives every matching object
+	ArchiveConfirmed
+)
+
+// ArchiveMatchingResult is the outcome of ArchiveMatching
+type ArchiveMatchingResult struct {
+	IDs      []string // Every object matched by the search, whether or not it was archived
+	Archived int      // Objects archived; always 0 for a dry run
+	DryRun   bool
 }
 
 type SearchObjectsResponse struct {
@@ -2,11 +2,63 @@ package objects
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
 )
 
+// ClientOption is a functional option for configuring the objects Client
+type ClientOption func(*Client)
+
+// WithObjectCache enables a read-through cache for ReadObject
+//
+// Entries are keyed by object type, id and the read's options (properties, associations, ...) and are served for
+// ttl. A successful UpdateObject, ArchiveObject or MergeObjects through the same client invalidates every cached
+// read of the ids involved. Changes made elsewhere are not seen until the entry expires
+func WithObjectCache(cache ObjectCache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = &objectCache{
+			cache: cache,
+			ttl:   ttl,
+			keys:  make(map[string]map[string]time.Time),
+		}
+	}
+}
+
+// WithAutoBatch coalesces concurrent ReadObject calls into BatchReadObjects requests
+//
+// Reads of the same object type with the same options that arrive within window of the first one are sent as one
+// batch read, which is flushed early once it holds maxBatch distinct IDs (HubSpot accepts at most 100). Each caller
+// still receives only its own object, or an *ObjectNotFoundError if the batch didn't return it. Reads using
+// WithAssociations are not batched
+func WithAutoBatch(window time.Duration, maxBatch int) ClientOption {
+	return func(c *Client) {
+		if maxBatch <= 0 || maxBatch > 100 {
+			maxBatch = 100
+		}
+		c.batcher = &autoBatcher{
+			window:   window,
+			maxBatch: maxBatch,
+			pending:  make(map[string]*pendingBatch),
+		}
+	}
+}
+
+// WithSchemaValidation validates property values against the object type's property definitions before sending
+//
+// Create, update and upsert calls fail with an *ObjectValidationError per invalid property, joined together, instead
+// of a 400 from HubSpot. Values are checked for unknown properties, string length, number, bool, date and enumeration
+// formats, and phone number and email formats. Definitions are fetched from the properties API per object type and
+// cached for ten minutes; a property missing from them is looked up again first, so one created in the meantime is
+// accepted
+func WithSchemaValidation() ClientOption {
+	return func(c *Client) {
+		c.validateSchema = true
+	}
+}
+
 // ObjectsOption is a functional option for Object calls Query Parameters
 type ObjectsOption func(*client.Request)
 
@@ -28,11 +80,11 @@ func WithAfter(after string) ObjectsOption {
 	}
 }
 
-// WithProperties specifies which properties to retrieve
+// WithProperties specifies which properties to retrieve; repeated calls add to the list
 func WithProperties(props []string) ObjectsOption {
 	return func(req *client.Request) {
 		if len(props) > 0 {
-			req.AddQueryParam("properties", strings.Join(props, ","))
+			req.AppendQueryParam("properties", strings.Join(props, ","))
 		}
 	}
 }
@@ -46,19 +98,58 @@ func WithPropertiesWithHistory(props []string) ObjectsOption {
 	}
 }
 
-// WithAssociations specifies which associations to retrieve
+// WithAssociations specifies which associations to retrieve; repeated calls add to the list
 func WithAssociations(associations []string) ObjectsOption {
 	return func(req *client.Request) {
 		if len(associations) > 0 {
-			req.AddQueryParam("associations", strings.Join(associations, ","))
+			req.AppendQueryParam("associations", strings.Join(associations, ","))
+		}
+	}
+}
+
+// associationLimitKey is the request metadata key set by WithAssociationLimit
+const associationLimitKey = "objects.associationLimit"
+
+// WithAssociationLimit returns up to n associated objects of each type requested with WithAssociations
+//
+// HubSpot cuts off the associations returned inline with an object and has no parameter to raise that cap, so when
+// a type is cut off ReadObject pages through the rest with the associations API until n are collected. Inline results
+// beyond n are dropped, and paging that doesn't end returns an error wrapping associations.ErrTooManyPages. Use the
+// v4 associations client's ListAssociations to page through very large sets yourself
+func WithAssociationLimit(n int) ObjectsOption {
+	return func(req *client.Request) {
+		if n > 0 {
+			req.SetMetadata(associationLimitKey, n)
 		}
 	}
 }
 
-// WithArchived specifies whether to include archived objects in the response
+// WithArchived requests archived objects; it is shorthand for WithArchivedStatus(true)
 func WithArchived() ObjectsOption {
+	return WithArchivedStatus(true)
+}
+
+// WithArchivedOnly requests archived objects only, leaving active objects out
+//
+// It is the same as WithArchived, named for reconciliation code that enumerates archived objects to purge them. Use it
+// with ListObjects or BatchReadObjects; the search endpoints never return archived objects, so they can't be used to
+// find them.
+func WithArchivedOnly() ObjectsOption {
+	return WithArchivedStatus(true)
+}
+
+// WithArchivedStatus sets the archived query parameter explicitly
+//
+// The archived parameter has three meaningful states:
+//   - not set: HubSpot's default for the endpoint, which is active objects only
+//   - false: active objects only, stated explicitly
+//   - true: archived objects only
+//
+// HubSpot has no value that returns active and archived objects together, so reconciling both requires one call
+// with WithArchivedStatus(false) and one with WithArchivedStatus(true).
+func WithArchivedStatus(archived bool) ObjectsOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("archived", "true")
+		req.AddQueryParam("archived", strconv.FormatBool(archived))
 	}
 }
 
@@ -68,3 +159,42 @@ func WithIDProperty(property string) ObjectsOption {
 		req.AddQueryParam("idProperty", property)
 	}
 }
+
+// allPropertiesKey is the request metadata key set by WithAllProperties
+const allPropertiesKey = "objects.allProperties"
+
+// WithAllProperties requests every property of the object type instead of HubSpot's small default set
+//
+// The property names are fetched from the properties API on first use and cached per object type for ten minutes,
+// so properties created afterwards are included once the cache is refreshed. It replaces any properties set with
+// WithProperties. Names that don't fit in the request URL are read with batch reads and merged into the results, so
+// object types with hundreds of properties don't exceed URL length limits
+func WithAllProperties() ObjectsOption {
+	return func(req *client.Request) {
+		req.SetMetadata(allPropertiesKey, true)
+	}
+}
+
+// allowEmptyKey is the request metadata key set by WithAllowEmpty
+const allowEmptyKey = "objects.allowEmpty"
+
+// WithAllowEmpty makes ListObjects return an empty slice instead of ErrNoObjectsFound when a page has no results
+func WithAllowEmpty() ObjectsOption {
+	return func(req *client.Request) {
+		req.SetMetadata(allowEmptyKey, true)
+	}
+}
+
+// orderedResultsKey is the request metadata key set by WithOrderedResults
+const orderedResultsKey = "objects.orderedResults"
+
+// WithOrderedResults reorders batch read/create results to match the order of the inputs
+//
+// HubSpot does not guarantee that batch results come back in input order. With this option Results has one
+// entry per input; inputs that errored are left as zero-value Objects. Reads are matched on ID (or on the
+// idProperty value), creates are matched on objectWriteTraceId, which is filled in with the input index when unset.
+func WithOrderedResults() ObjectsOption {
+	return func(req *client.Request) {
+		req.SetMetadata(orderedResultsKey, true)
+	}
+}
@@ -0,0 +1,255 @@
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
+)
+
+// maxStringPropertyLength is the maximum number of characters HubSpot stores in a string property
+const maxStringPropertyLength = 65536
+
+// phonePattern matches digits with the separators and extension markers HubSpot accepts in phone numbers
+var phonePattern = regexp.MustCompile(`^\+?[0-9 ().\-]+(\s*(x|ext\.?)\s*[0-9]+)?$`)
+
+// PropertyDefinition is the part of a property definition used to validate values before they are sent
+type PropertyDefinition struct {
+	Name      string           `json:"name"`
+	Type      string           `json:"type"`
+	FieldType string           `json:"fieldType"`
+	Options   []PropertyOption `json:"options"`
+}
+
+// PropertyOption is an allowed value of an enumeration property
+type PropertyOption struct {
+	Value string `json:"value"`
+}
+
+// propertyDefinitionsResponse is the response from the properties API
+type propertyDefinitionsResponse struct {
+	Results []PropertyDefinition `json:"results"`
+}
+
+// propertyDefinitionsTTL is how long fetched property definitions are used before they are fetched again, so a
+// long-running client picks up properties created or changed in HubSpot
+const propertyDefinitionsTTL = 10 * time.Minute
+
+// propertyDefinitions caches the property definitions of each object type, fetched from the properties API on
+// first use and again once they are older than propertyDefinitionsTTL. It backs WithSchemaValidation and
+// WithAllProperties
+//
+// Definitions are cached per account, keyed by client.TenantKey, since custom properties differ between accounts
+type propertyDefinitions struct {
+	mu     sync.Mutex
+	byType map[string]definitionsEntry
+}
+
+// definitionsEntry is the property definitions of one object type and when they were fetched
+type definitionsEntry struct {
+	definitions map[string]PropertyDefinition
+	fetchedAt   time.Time
+}
+
+// validateProperties checks every property against the definitions for objectType and returns an
+// *ObjectValidationError per invalid property, joined in property name order
+func (c *Client) validateProperties(ctx context.Context, objectType string, properties map[string]string) error {
+	definitions, err := c.definitions.load(ctx, c.apiClient, objectType)
+	if err != nil {
+		return err
+	}
+
+	// A property that isn't among the cached definitions may have been created since they were fetched
+	for name := range properties {
+		if _, ok := definitions[name]; !ok {
+			if definitions, err = c.definitions.fetch(ctx, c.apiClient, objectType); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var errs []error
+	for _, name := range names {
+		definition, ok := definitions[name]
+		if !ok {
+			errs = append(errs, &ObjectValidationError{Field: name, Message: fmt.Sprintf("property does not exist on %s", objectType)})
+			continue
+		}
+		if message := validatePropertyValue(definition, properties[name]); message != "" {
+			errs = append(errs, &ObjectValidationError{Field: name, Message: message})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// names returns the names of every property of objectType in sorted order
+func (pd *propertyDefinitions) names(ctx context.Context, apiClient *client.Client, objectType string) ([]string, error) {
+	definitions, err := pd.load(ctx, apiClient, objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// load returns the property definitions for objectType keyed by name, fetching them on first use and once the
+// cached ones are older than propertyDefinitionsTTL
+func (pd *propertyDefinitions) load(ctx context.Context, apiClient *client.Client, objectType string) (map[string]PropertyDefinition, error) {
+	pd.mu.Lock()
+	entry, ok := pd.byType[client.TenantKey(ctx)+":"+objectType]
+	pd.mu.Unlock()
+
+	if ok && time.Since(entry.fetchedAt) < propertyDefinitionsTTL {
+		return entry.definitions, nil
+	}
+	return pd.fetch(ctx, apiClient, objectType)
+}
+
+// fetch requests the property definitions for objectType from the properties API and caches them
+//
+// pd.mu is not held during the request, so loads of other object types aren't blocked behind it
+func (pd *propertyDefinitions) fetch(ctx context.Context, apiClient *client.Client, objectType string) (map[string]PropertyDefinition, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/properties/%s", objectType))
+	req.WithContext(ctx)
+	req.WithResourceType("properties")
+
+	resp, err := apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load property definitions for %s: %w", objectType, err)
+	}
+
+	var propertiesResp propertyDefinitionsResponse
+	if err := json.Unmarshal(resp.Body, &propertiesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties response: %w", tools.DecodeError(err, resp))
+	}
+
+	definitions := make(map[string]PropertyDefinition, len(propertiesResp.Results))
+	for _, definition := range propertiesResp.Results {
+		definitions[definition.Name] = definition
+	}
+
+	pd.mu.Lock()
+	pd.byType[client.TenantKey(ctx)+":"+objectType] = definitionsEntry{definitions: definitions, fetchedAt: time.Now()}
+	pd.mu.Unlock()
+
+	return definitions, nil
+}
+
+// validatePropertyValue returns why value is invalid for the property, or an empty string if it is valid
+//
+// Empty values are always valid since they clear the property
+func validatePropertyValue(definition PropertyDefinition, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	switch definition.Type {
+	case "string", "phone_number":
+		if n := utf8.RuneCountInString(value); n > maxStringPropertyLength {
+			return fmt.Sprintf("value is %d characters, the maximum is %d", n, maxStringPropertyLength)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Sprintf("%q is not a number", value)
+		}
+	case "bool":
+		if value != "true" && value != "false" {
+			return fmt.Sprintf("%q is not true or false", value)
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, value); err != nil && !isEpochMillis(value) {
+			return fmt.Sprintf("%q is not a YYYY-MM-DD date or epoch milliseconds", value)
+		}
+	case "datetime":
+		if _, err := time.Parse(time.RFC3339, value); err != nil && !isEpochMillis(value) {
+			return fmt.Sprintf("%q is not an RFC 3339 timestamp or epoch milliseconds", value)
+		}
+	case "enumeration":
+		if len(definition.Options) == 0 {
+			break
+		}
+		for _, option := range strings.Split(value, ";") {
+			if !slices.Contains(definition.Options, PropertyOption{Value: option}) {
+				return fmt.Sprintf("%q is not one of the property's options", option)
+			}
+		}
+	}
+
+	switch {
+	case definition.FieldType == "phonenumber" || definition.Type == "phone_number":
+		if !phonePattern.MatchString(value) {
+			return fmt.Sprintf("%q is not a valid phone number", value)
+		}
+	case definition.Name == "email" || definition.FieldType == "email":
+		if address, err := mail.ParseAddress(value); err != nil || address.Address != value {
+			return fmt.Sprintf("%q is not a valid email address", value)
+		}
+	}
+
+	return ""
+}
+
+// isEpochMillis reports whether value is an integer millisecond timestamp
+func isEpochMillis(value string) bool {
+	_, err := strconv.ParseInt(value, 10, 64)
+	return err == nil
+}
+
+// validateSearchFilters checks that every filter has the values its operator needs and returns a
+// *SearchValidationError for the first one that doesn't
+func validateSearchFilters(groups []SearchFilterGroup) error {
+	for g, group := range groups {
+		for f, filter := range group.Filters {
+			var message string
+			switch filter.Operator {
+			case Between:
+				if filter.Value == "" || filter.HighValue == "" {
+					message = "requires Value and HighValue"
+				}
+			case In, NotIn:
+				if len(filter.Values) == 0 {
+					message = "requires Values"
+				}
+			case HasProperty, NotHasProperty:
+				if filter.Value != "" || filter.HighValue != "" || len(filter.Values) > 0 {
+					message = "takes no Value, HighValue or Values"
+				}
+			}
+			if message != "" {
+				return &SearchValidationError{
+					FilterGroup:  g,
+					Filter:       f,
+					PropertyName: filter.PropertyName,
+					Operator:     filter.Operator,
+					Message:      message,
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Package objecttypes provides the names and type IDs of HubSpot's standard CRM objects
+//
+// The names are the plural forms used in CRM API paths, e.g. /crm/v3/objects/contacts. Either a name or its
+// type ID can be passed wherever the SDK takes an objectType. The type IDs of custom objects, which differ between
+// accounts, can be looked up with the schemas client's ResolveObjectTypeID.
+package objecttypes
+
+// Standard object type names
+const (
+	Contacts  = "contacts"
+	Companies = "companies"
+	Deals     = "deals"
+	Tickets   = "tickets"
+	LineItems = "line_items"
+	Products  = "products"
+	Quotes    = "quotes"
+	Orders    = "orders"
+)
+
+// Standard object type IDs
+const (
+	ContactsID  = "0-1"
+	CompaniesID = "0-2"
+	DealsID     = "0-3"
+	TicketsID   = "0-5"
+	ProductsID  = "0-7"
+	LineItemsID = "0-8"
+	QuotesID    = "0-14"
+	OrdersID    = "0-123"
+)
+
+var typeIDs = map[string]string{
+	Contacts:  ContactsID,
+	Companies: CompaniesID,
+	Deals:     DealsID,
+	Tickets:   TicketsID,
+	LineItems: LineItemsID,
+	Products:  ProductsID,
+	Quotes:    QuotesID,
+	Orders:    OrdersID,
+}
+
+// TypeID returns the type ID for a standard object type name
+func TypeID(name string) (string, bool) {
+	id, ok := typeIDs[name]
+	return id, ok
+}
+
+// Name returns the standard object type name for a type ID
+func Name(typeID string) (string, bool) {
+	for name, id := range typeIDs {
+		if id == typeID {
+			return name, true
+		}
+	}
+	return "", false
+}
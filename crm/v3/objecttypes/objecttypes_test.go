@@ -0,0 +1,52 @@
+package objecttypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTypeID_Standard tests that each constant maps to the objectTypeId HubSpot documents for the standard object
+func TestTypeID_Standard(t *testing.T) {
+	tests := []struct {
+		name   string
+		typeID string
+	}{
+		{"contacts", "0-1"},
+		{"companies", "0-2"},
+		{"deals", "0-3"},
+		{"tickets", "0-5"},
+		{"products", "0-7"},
+		{"line_items", "0-8"},
+		{"quotes", "0-14"},
+		{"orders", "0-123"},
+	}
+
+	constants := []string{Contacts, Companies, Deals, Tickets, Products, LineItems, Quotes, Orders}
+	idConstants := []string{ContactsID, CompaniesID, DealsID, TicketsID, ProductsID, LineItemsID, QuotesID, OrdersID}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, constants[i])
+			assert.Equal(t, tt.typeID, idConstants[i])
+
+			typeID, ok := TypeID(tt.name)
+			require.True(t, ok)
+			assert.Equal(t, tt.typeID, typeID)
+
+			name, ok := Name(tt.typeID)
+			require.True(t, ok)
+			assert.Equal(t, tt.name, name)
+		})
+	}
+}
+
+// TestTypeID_Unknown tests lookups for names and IDs that aren't standard objects
+func TestTypeID_Unknown(t *testing.T) {
+	_, ok := TypeID("contact")
+	assert.False(t, ok)
+
+	_, ok = Name("2-123456")
+	assert.False(t, ok)
+}
@@ -0,0 +1,22 @@
+package orders
+
+import "context"
+
+// API is the set of orders client methods, implemented by *Client
+//
+// Code that calls the orders API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateOrder(ctx context.Context, input *CreateOrderInput) (*Order, error)
+	GetOrder(ctx context.Context, orderID string, opts ...OrderOption) (*Order, error)
+	UpdateOrder(ctx context.Context, orderID string, input *UpdateOrderInput) (*Order, error)
+	ArchiveOrder(ctx context.Context, orderID string) error
+	ListOrders(ctx context.Context, opts ...OrderOption) (*ListOrdersResponse, error)
+	BatchReadOrders(ctx context.Context, input *BatchReadOrdersInput) (*BatchOrdersResponse, error)
+	BatchCreateOrders(ctx context.Context, input *BatchCreateOrdersInput) (*BatchOrdersResponse, error)
+	BatchUpdateOrders(ctx context.Context, input *BatchUpdateOrdersInput) (*BatchOrdersResponse, error)
+	BatchArchiveOrders(ctx context.Context, input *BatchArchiveOrdersInput) error
+	SearchOrders(ctx context.Context, input *SearchOrdersInput) (*SearchOrdersResponse, error)
+}
+
+var _ API = (*Client)(nil)
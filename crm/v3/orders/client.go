@@ -5,8 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
 // Client represents the Orders API client
@@ -23,10 +26,16 @@ func NewClient(apiClient *client.Client) *Client {
 
 // CreateOrder creates a new order
 func (c *Client) CreateOrder(ctx context.Context, input *CreateOrderInput) (*Order, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Orders, input.Properties)
+
 	req := client.NewRequest("POST", "/crm/v3/objects/orders")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Orders)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -35,7 +44,7 @@ func (c *Client) CreateOrder(ctx context.Context, input *CreateOrderInput) (*Ord
 
 	var order Order
 	if err := json.Unmarshal(resp.Body, &order); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal order response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal order response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &order, nil
@@ -45,7 +54,7 @@ func (c *Client) CreateOrder(ctx context.Context, input *CreateOrderInput) (*Ord
 func (c *Client) GetOrder(ctx context.Context, orderID string, opts ...OrderOption) (*Order, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/orders/%s", orderID))
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 
 	for _, opt := range opts {
 		opt(req)
@@ -53,12 +62,12 @@ func (c *Client) GetOrder(ctx context.Context, orderID string, opts ...OrderOpti
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, client.ParseNotFoundError(err, objecttypes.Orders, orderID)
 	}
 
 	var order Order
 	if err := json.Unmarshal(resp.Body, &order); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal order response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal order response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &order, nil
@@ -68,7 +77,7 @@ func (c *Client) GetOrder(ctx context.Context, orderID string, opts ...OrderOpti
 func (c *Client) UpdateOrder(ctx context.Context, orderID string, input *UpdateOrderInput) (*Order, error) {
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/orders/%s", orderID))
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -78,7 +87,7 @@ func (c *Client) UpdateOrder(ctx context.Context, orderID string, input *UpdateO
 
 	var order Order
 	if err := json.Unmarshal(resp.Body, &order); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal order response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal order response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &order, nil
@@ -88,7 +97,7 @@ func (c *Client) UpdateOrder(ctx context.Context, orderID string, input *UpdateO
 func (c *Client) ArchiveOrder(ctx context.Context, orderID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/orders/%s", orderID))
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 
 	_, err := c.apiClient.Do(ctx, req)
 	return err
@@ -98,7 +107,8 @@ func (c *Client) ArchiveOrder(ctx context.Context, orderID string) error {
 func (c *Client) ListOrders(ctx context.Context, opts ...OrderOption) (*ListOrdersResponse, error) {
 	req := client.NewRequest("GET", "/crm/v3/objects/orders")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
+	req.WithPaging()
 
 	for _, opt := range opts {
 		opt(req)
@@ -111,7 +121,7 @@ func (c *Client) ListOrders(ctx context.Context, opts ...OrderOption) (*ListOrde
 
 	var listResp ListOrdersResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal orders list response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal orders list response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp, nil
@@ -121,7 +131,7 @@ func (c *Client) ListOrders(ctx context.Context, opts ...OrderOption) (*ListOrde
 func (c *Client) BatchReadOrders(ctx context.Context, input *BatchReadOrdersInput) (*BatchOrdersResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/orders/batch/read")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -131,7 +141,7 @@ func (c *Client) BatchReadOrders(ctx context.Context, input *BatchReadOrdersInpu
 
 	var batchResp BatchOrdersResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -139,10 +149,19 @@ func (c *Client) BatchReadOrders(ctx context.Context, input *BatchReadOrdersInpu
 
 // BatchCreateOrders creates multiple orders
 func (c *Client) BatchCreateOrders(ctx context.Context, input *BatchCreateOrdersInput) (*BatchOrdersResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Orders, withDefaults.Inputs[i].Properties)
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/orders/batch/create")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Orders)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -151,7 +170,7 @@ func (c *Client) BatchCreateOrders(ctx context.Context, input *BatchCreateOrders
 
 	var batchResp BatchOrdersResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -161,7 +180,7 @@ func (c *Client) BatchCreateOrders(ctx context.Context, input *BatchCreateOrders
 func (c *Client) BatchUpdateOrders(ctx context.Context, input *BatchUpdateOrdersInput) (*BatchOrdersResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/orders/batch/update")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -171,7 +190,7 @@ func (c *Client) BatchUpdateOrders(ctx context.Context, input *BatchUpdateOrders
 
 	var batchResp BatchOrdersResponse
 	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &batchResp, nil
@@ -181,7 +200,7 @@ func (c *Client) BatchUpdateOrders(ctx context.Context, input *BatchUpdateOrders
 func (c *Client) BatchArchiveOrders(ctx context.Context, input *BatchArchiveOrdersInput) error {
 	req := client.NewRequest("POST", "/crm/v3/objects/orders/batch/archive")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
 	req.WithBody(input)
 
 	_, err := c.apiClient.Do(ctx, req)
@@ -192,7 +211,8 @@ func (c *Client) BatchArchiveOrders(ctx context.Context, input *BatchArchiveOrde
 func (c *Client) SearchOrders(ctx context.Context, input *SearchOrdersInput) (*SearchOrdersResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/orders/search")
 	req.WithContext(ctx)
-	req.WithResourceType("orders")
+	req.WithResourceType(objecttypes.Orders)
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -202,7 +222,7 @@ func (c *Client) SearchOrders(ctx context.Context, input *SearchOrdersInput) (*S
 
 	var searchResp SearchOrdersResponse
 	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &searchResp, nil
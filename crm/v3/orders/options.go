@@ -10,10 +10,10 @@ import (
 // OrderOption represents a functional option for order requests
 type OrderOption func(*client.Request)
 
-// WithProperties specifies which properties to return
+// WithProperties specifies which properties to return; repeated calls add to the list
 func WithProperties(properties []string) OrderOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("properties", strings.Join(properties, ","))
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
 	}
 }
 
@@ -24,10 +24,10 @@ func WithPropertiesWithHistory(properties []string) OrderOption {
 	}
 }
 
-// WithAssociations specifies which associations to return
+// WithAssociations specifies which associations to return; repeated calls add to the list
 func WithAssociations(associations []string) OrderOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("associations", strings.Join(associations, ","))
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
 	}
 }
 
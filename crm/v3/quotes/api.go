@@ -0,0 +1,23 @@
+package quotes
+
+import "context"
+
+// API is the set of quotes client methods, implemented by *Client
+//
+// Code that calls the quotes API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateQuote(ctx context.Context, input *CreateQuoteInput) (*Quote, error)
+	CreateQuoteForDeal(ctx context.Context, dealID string, lineItemIDs []string, input *CreateQuoteInput) (*Quote, error)
+	GetQuote(ctx context.Context, quoteID string, opts ...QuoteOption) (*Quote, error)
+	UpdateQuote(ctx context.Context, quoteID string, input *UpdateQuoteInput) (*Quote, error)
+	ArchiveQuote(ctx context.Context, quoteID string) error
+	ListQuotes(ctx context.Context, opts ...QuoteOption) (*ListQuotesResponse, error)
+	BatchReadQuotes(ctx context.Context, input *BatchReadQuotesInput) (*BatchQuotesResponse, error)
+	BatchCreateQuotes(ctx context.Context, input *BatchCreateQuotesInput) (*BatchQuotesResponse, error)
+	BatchUpdateQuotes(ctx context.Context, input *BatchUpdateQuotesInput) (*BatchQuotesResponse, error)
+	BatchArchiveQuotes(ctx context.Context, input *BatchArchiveQuotesInput) error
+	SearchQuotes(ctx context.Context, input *SearchQuotesInput) (*SearchQuotesResponse, error)
+}
+
+var _ API = (*Client)(nil)
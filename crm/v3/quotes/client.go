@@ -0,0 +1,253 @@
+// Package quotes provides client methods for the HubSpot CRM Quotes API
+package quotes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
+)
+
+// Client represents the Quotes API client
+type Client struct {
+	apiClient *client.Client
+}
+
+// NewClient creates a new quotes client
+func NewClient(apiClient *client.Client) *Client {
+	return &Client{
+		apiClient: apiClient,
+	}
+}
+
+// CreateQuote creates a new quote
+func (c *Client) CreateQuote(ctx context.Context, input *CreateQuoteInput) (*Quote, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Quotes, input.Properties)
+
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := json.Unmarshal(resp.Body, &quote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quote response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &quote, nil
+}
+
+// CreateQuoteForDeal creates a quote associated to the deal dealID and the line items lineItemIDs
+//
+// The associations are made in the create request, so the quote never exists without its deal and line items. Pass
+// a nil lineItemIDs to associate the deal only. Any associations already in input, such as the quote template, are
+// kept. input is not modified
+func (c *Client) CreateQuoteForDeal(ctx context.Context, dealID string, lineItemIDs []string, input *CreateQuoteInput) (*Quote, error) {
+	withAssociations := *input
+	withAssociations.Associations = slices.Clone(input.Associations)
+	withAssociations.Associations = append(withAssociations.Associations, newAssociation(dealID, AssociationTypeQuoteToDeal))
+	for _, lineItemID := range lineItemIDs {
+		withAssociations.Associations = append(withAssociations.Associations, newAssociation(lineItemID, AssociationTypeQuoteToLineItem))
+	}
+
+	return c.CreateQuote(ctx, &withAssociations)
+}
+
+// newAssociation returns a HubSpot defined association of typeID to the record id
+func newAssociation(id string, typeID int) Association {
+	return Association{
+		To:    AssociationTarget{ID: id},
+		Types: []AssociationSpec{{AssociationCategory: AssociationCategoryHubSpotDefined, AssociationTypeID: typeID}},
+	}
+}
+
+// GetQuote retrieves a quote by ID
+func (c *Client) GetQuote(ctx context.Context, quoteID string, opts ...QuoteOption) (*Quote, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/quotes/%s", quoteID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, client.ParseNotFoundError(err, objecttypes.Quotes, quoteID)
+	}
+
+	var quote Quote
+	if err := json.Unmarshal(resp.Body, &quote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quote response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &quote, nil
+}
+
+// UpdateQuote updates a quote
+func (c *Client) UpdateQuote(ctx context.Context, quoteID string, input *UpdateQuoteInput) (*Quote, error) {
+	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/quotes/%s", quoteID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote Quote
+	if err := json.Unmarshal(resp.Body, &quote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quote response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &quote, nil
+}
+
+// ArchiveQuote archives (deletes) a quote
+func (c *Client) ArchiveQuote(ctx context.Context, quoteID string) error {
+	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/quotes/%s", quoteID))
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
+
+// ListQuotes lists quotes with optional filters
+func (c *Client) ListQuotes(ctx context.Context, opts ...QuoteOption) (*ListQuotesResponse, error) {
+	req := client.NewRequest("GET", "/crm/v3/objects/quotes")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithPaging()
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var listResp ListQuotesResponse
+	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal quotes list response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &listResp, nil
+}
+
+// BatchReadQuotes retrieves multiple quotes by ID
+func (c *Client) BatchReadQuotes(ctx context.Context, input *BatchReadQuotesInput) (*BatchQuotesResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes/batch/read")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchQuotesResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchCreateQuotes creates multiple quotes
+func (c *Client) BatchCreateQuotes(ctx context.Context, input *BatchCreateQuotesInput) (*BatchQuotesResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Quotes, withDefaults.Inputs[i].Properties)
+	}
+
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes/batch/create")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(&withDefaults)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchQuotesResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchUpdateQuotes updates multiple quotes
+func (c *Client) BatchUpdateQuotes(ctx context.Context, input *BatchUpdateQuotesInput) (*BatchQuotesResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes/batch/update")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchResp BatchQuotesResponse
+	if err := json.Unmarshal(resp.Body, &batchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &batchResp, nil
+}
+
+// BatchArchiveQuotes archives multiple quotes
+func (c *Client) BatchArchiveQuotes(ctx context.Context, input *BatchArchiveQuotesInput) error {
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes/batch/archive")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithBody(input)
+
+	_, err := c.apiClient.Do(ctx, req)
+	return err
+}
+
+// SearchQuotes searches for quotes
+func (c *Client) SearchQuotes(ctx context.Context, input *SearchQuotesInput) (*SearchQuotesResponse, error) {
+	req := client.NewRequest("POST", "/crm/v3/objects/quotes/search")
+	req.WithContext(ctx)
+	req.WithResourceType(objecttypes.Quotes)
+	req.WithPaging()
+	req.WithBody(input)
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp SearchQuotesResponse
+	if err := json.Unmarshal(resp.Body, &searchResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &searchResp, nil
+}
@@ -0,0 +1,194 @@
+package quotes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper functions
+func setupMockServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(handler))
+
+	apiClient, err := client.NewClient(
+		client.WithBaseURL(server.URL),
+		client.WithAccessToken("test-token"),
+		client.WithRateLimitEnabled(false),
+		client.WithRetryEnabled(false),
+	)
+	require.NoError(t, err)
+
+	quotesClient := NewClient(apiClient)
+	return server, quotesClient
+}
+
+func respondJSON(w http.ResponseWriter, statusCode int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(body))
+}
+
+const quoteJSON = `{
+	"id": "555",
+	"properties": {
+		"hs_title": "Renewal 2025",
+		"hs_expiration_date": "2025-03-31",
+		"hs_status": "DRAFT"
+	},
+	"createdAt": "2024-01-01T00:00:00.000Z",
+	"updatedAt": "2024-01-01T00:00:00.000Z",
+	"archived": false
+}`
+
+// TestNewClient tests client creation
+func TestNewClient(t *testing.T) {
+	apiClient, err := client.NewClient()
+	require.NoError(t, err)
+
+	quotesClient := NewClient(apiClient)
+	assert.NotNil(t, quotesClient)
+	assert.NotNil(t, quotesClient.apiClient)
+}
+
+// TestCreateQuote_Success tests quote creation with typed fields
+func TestCreateQuote_Success(t *testing.T) {
+	var body CreateQuoteInput
+	server, quotesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/quotes", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		respondJSON(w, http.StatusCreated, quoteJSON)
+	})
+	defer server.Close()
+
+	input := &CreateQuoteInput{
+		Properties: QuoteFields{
+			Title:          "Renewal 2025",
+			ExpirationDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+			Status:         StatusDraft,
+		}.Properties(nil),
+	}
+
+	quote, err := quotesClient.CreateQuote(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "2025-03-31", body.Properties[PropertyExpirationDate])
+	assert.Equal(t, "DRAFT", body.Properties[PropertyStatus])
+	assert.Equal(t, "555", quote.ID)
+	assert.Equal(t, "Renewal 2025", quote.Title())
+	assert.Equal(t, StatusDraft, quote.Status())
+	expires, ok := quote.ExpirationDate()
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), expires)
+}
+
+// TestCreateQuoteForDeal_Success tests that the deal and line item associations are sent with the create request
+func TestCreateQuoteForDeal_Success(t *testing.T) {
+	var body CreateQuoteInput
+	server, quotesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/quotes", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		respondJSON(w, http.StatusCreated, quoteJSON)
+	})
+	defer server.Close()
+
+	input := &CreateQuoteInput{
+		Properties:   map[string]string{PropertyTitle: "Renewal 2025"},
+		Associations: []Association{newAssociation("9", AssociationTypeQuoteToTemplate)},
+	}
+
+	quote, err := quotesClient.CreateQuoteForDeal(context.Background(), "100", []string{"200", "201"}, input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "555", quote.ID)
+	require.Len(t, body.Associations, 4)
+	assert.Equal(t, "9", body.Associations[0].To.ID)
+	assert.Equal(t, AssociationTypeQuoteToTemplate, body.Associations[0].Types[0].AssociationTypeID)
+	assert.Equal(t, "100", body.Associations[1].To.ID)
+	assert.Equal(t, AssociationTypeQuoteToDeal, body.Associations[1].Types[0].AssociationTypeID)
+	assert.Equal(t, "200", body.Associations[2].To.ID)
+	assert.Equal(t, AssociationTypeQuoteToLineItem, body.Associations[2].Types[0].AssociationTypeID)
+	assert.Equal(t, "201", body.Associations[3].To.ID)
+	assert.Equal(t, AssociationCategoryHubSpotDefined, body.Associations[3].Types[0].AssociationCategory)
+	assert.Len(t, input.Associations, 1)
+}
+
+// TestUpdateQuote_Success tests updating a quote
+func TestUpdateQuote_Success(t *testing.T) {
+	server, quotesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/crm/v3/objects/quotes/555", r.URL.Path)
+		respondJSON(w, http.StatusOK, quoteJSON)
+	})
+	defer server.Close()
+
+	input := &UpdateQuoteInput{Properties: QuoteFields{Status: StatusPendingApproval}.Properties(nil)}
+
+	quote, err := quotesClient.UpdateQuote(context.Background(), "555", input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "555", quote.ID)
+}
+
+// TestBatchReadQuotes_Success tests batch read
+func TestBatchReadQuotes_Success(t *testing.T) {
+	server, quotesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v3/objects/quotes/batch/read", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"status": "COMPLETE", "results": [`+quoteJSON+`]}`)
+	})
+	defer server.Close()
+
+	resp, err := quotesClient.BatchReadQuotes(context.Background(), &BatchReadQuotesInput{Properties: []string{PropertyTitle}})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Results, 1)
+}
+
+// TestSearchQuotes_Success tests searching quotes by status
+func TestSearchQuotes_Success(t *testing.T) {
+	server, quotesClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v3/objects/quotes/search", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{"total": 1, "results": [`+quoteJSON+`]}`)
+	})
+	defer server.Close()
+
+	input := &SearchQuotesInput{
+		FilterGroups: []FilterGroup{{
+			Filters: []Filter{{PropertyName: PropertyStatus, Operator: "EQ", Value: StatusDraft}},
+		}},
+	}
+
+	resp, err := quotesClient.SearchQuotes(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+}
+
+// TestQuote_ExpirationDate tests parsing both date formats HubSpot returns
+func TestQuote_ExpirationDate(t *testing.T) {
+	quote := Quote{Properties: map[string]string{PropertyExpirationDate: "2025-03-31T00:00:00Z"}}
+	expires, ok := quote.ExpirationDate()
+	require.True(t, ok)
+	assert.Equal(t, 2025, expires.Year())
+
+	quote.Properties[PropertyExpirationDate] = "soon"
+	_, ok = quote.ExpirationDate()
+	assert.False(t, ok)
+
+	_, ok = (&Quote{}).ExpirationDate()
+	assert.False(t, ok)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
@@ -0,0 +1,236 @@
+package quotes
+
+import "time"
+
+// Quote properties modelled by the typed accessors on Quote and the typed fields on QuoteFields
+const (
+	PropertyTitle          = "hs_title"
+	PropertyExpirationDate = "hs_expiration_date"
+	PropertyStatus         = "hs_status"
+	PropertyLanguage       = "hs_language"
+	PropertyCurrency       = "hs_currency"
+)
+
+// QuoteStatus is the approval state of a quote, stored in hs_status
+type QuoteStatus string
+
+const (
+	StatusDraft             QuoteStatus = "DRAFT"
+	StatusApprovalNotNeeded QuoteStatus = "APPROVAL_NOT_NEEDED"
+	StatusPendingApproval   QuoteStatus = "PENDING_APPROVAL"
+	StatusApproved          QuoteStatus = "APPROVED"
+	StatusRejected          QuoteStatus = "REJECTED"
+)
+
+// Association values used when creating a quote already associated to its deal, line items and template
+const (
+	AssociationCategoryHubSpotDefined = "HUBSPOT_DEFINED"
+	AssociationTypeQuoteToDeal        = 64
+	AssociationTypeQuoteToLineItem    = 67
+	AssociationTypeQuoteToContact     = 69
+	AssociationTypeQuoteToCompany     = 71
+	AssociationTypeQuoteToTemplate    = 286
+)
+
+// expirationDateLayout is the format HubSpot accepts for hs_expiration_date
+const expirationDateLayout = "2006-01-02"
+
+// Quote represents a HubSpot quote object
+type Quote struct {
+	ID                    string                           `json:"id"`
+	Properties            map[string]string                `json:"properties"`
+	PropertiesWithHistory map[string][]PropertyWithHistory `json:"propertiesWithHistory"`
+	CreatedAt             string                           `json:"createdAt"`
+	UpdatedAt             string                           `json:"updatedAt"`
+	Archived              bool                             `json:"archived"`
+	ArchivedAt            string                           `json:"archivedAt"`
+}
+
+// Title returns the quote's title
+func (q *Quote) Title() string {
+	return q.Properties[PropertyTitle]
+}
+
+// Status returns the quote's approval status
+func (q *Quote) Status() QuoteStatus {
+	return QuoteStatus(q.Properties[PropertyStatus])
+}
+
+// ExpirationDate returns the date the quote expires, and false if it is unset, was not requested or can't be parsed
+//
+// HubSpot returns hs_expiration_date either as a date or as a full timestamp; both are accepted
+func (q *Quote) ExpirationDate() (time.Time, bool) {
+	value := q.Properties[PropertyExpirationDate]
+	if value == "" {
+		return time.Time{}, false
+	}
+	if date, err := time.Parse(expirationDateLayout, value); err == nil {
+		return date, true
+	}
+	if date, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return date, true
+	}
+	return time.Time{}, false
+}
+
+// QuoteFields holds the commonly set quote properties as typed values
+//
+// Zero values are left out
+type QuoteFields struct {
+	Title          string
+	ExpirationDate time.Time
+	Status         QuoteStatus
+	Language       string
+	Currency       string
+}
+
+// Properties returns the fields as a properties map, merged over extra
+//
+// The typed fields win over entries of extra with the same name. extra is not modified
+func (f QuoteFields) Properties(extra map[string]string) map[string]string {
+	properties := make(map[string]string, len(extra)+5)
+	for k, v := range extra {
+		properties[k] = v
+	}
+
+	if f.Title != "" {
+		properties[PropertyTitle] = f.Title
+	}
+	if !f.ExpirationDate.IsZero() {
+		properties[PropertyExpirationDate] = f.ExpirationDate.Format(expirationDateLayout)
+	}
+	if f.Status != "" {
+		properties[PropertyStatus] = string(f.Status)
+	}
+	if f.Language != "" {
+		properties[PropertyLanguage] = f.Language
+	}
+	if f.Currency != "" {
+		properties[PropertyCurrency] = f.Currency
+	}
+
+	return properties
+}
+
+// PropertyWithHistory represents a property with its historical values
+type PropertyWithHistory struct {
+	Value           string `json:"value"`
+	Timestamp       string `json:"timestamp"`
+	SourceType      string `json:"sourceType"`
+	SourceID        string `json:"sourceId"`
+	SourceLabel     string `json:"sourceLabel"`
+	UpdatedByUserID int    `json:"updatedByUserId"`
+}
+
+// CreateQuoteInput represents the input for creating a quote
+type CreateQuoteInput struct {
+	Properties   map[string]string `json:"properties"`
+	Associations []Association     `json:"associations,omitempty"`
+}
+
+// Association associates a new quote to an existing record in the create request
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationSpec `json:"types"`
+}
+
+// AssociationTarget identifies the record to associate to
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationSpec defines the type of an association
+type AssociationSpec struct {
+	AssociationCategory string `json:"associationCategory"`
+	AssociationTypeID   int    `json:"associationTypeId"`
+}
+
+// UpdateQuoteInput represents the input for updating a quote
+type UpdateQuoteInput struct {
+	Properties map[string]string `json:"properties"`
+}
+
+// ListQuotesResponse represents the response from listing quotes
+type ListQuotesResponse struct {
+	Results []Quote `json:"results"`
+	Paging  *Paging `json:"paging"`
+}
+
+// Paging represents pagination information
+type Paging struct {
+	Next *PagingLink `json:"next"`
+	Prev *PagingLink `json:"prev"`
+}
+
+// PagingLink represents a pagination link
+type PagingLink struct {
+	After string `json:"after"`
+	Link  string `json:"link"`
+}
+
+// BatchReadQuotesInput represents input for batch read
+type BatchReadQuotesInput struct {
+	Properties            []string `json:"properties"`
+	PropertiesWithHistory []string `json:"propertiesWithHistory"`
+	IDProperty            string   `json:"idProperty"`
+	Inputs                []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchCreateQuotesInput represents input for batch create
+type BatchCreateQuotesInput struct {
+	Inputs []CreateQuoteInput `json:"inputs"`
+}
+
+// BatchUpdateQuotesInput represents input for batch update
+type BatchUpdateQuotesInput struct {
+	Inputs []struct {
+		ID         string            `json:"id"`
+		Properties map[string]string `json:"properties"`
+	} `json:"inputs"`
+}
+
+// BatchArchiveQuotesInput represents input for batch archive
+type BatchArchiveQuotesInput struct {
+	Inputs []struct {
+		ID string `json:"id"`
+	} `json:"inputs"`
+}
+
+// BatchQuotesResponse represents response from batch operations
+type BatchQuotesResponse struct {
+	Status      string  `json:"status"`
+	Results     []Quote `json:"results"`
+	StartedAt   string  `json:"startedAt"`
+	CompletedAt string  `json:"completedAt"`
+}
+
+// SearchQuotesInput represents input for searching quotes
+type SearchQuotesInput struct {
+	FilterGroups []FilterGroup `json:"filterGroups"`
+	Sorts        []string      `json:"sorts"`
+	Query        string        `json:"query"`
+	Properties   []string      `json:"properties"`
+	Limit        int           `json:"limit"`
+	After        string        `json:"after"`
+}
+
+// FilterGroup represents a group of filters
+type FilterGroup struct {
+	Filters []Filter `json:"filters"`
+}
+
+// Filter represents a single filter
+type Filter struct {
+	PropertyName string `json:"propertyName"`
+	Operator     string `json:"operator"`
+	Value        any    `json:"value"`
+}
+
+// SearchQuotesResponse represents response from search
+type SearchQuotesResponse struct {
+	Total   int     `json:"total"`
+	Results []Quote `json:"results"`
+	Paging  *Paging `json:"paging"`
+}
@@ -0,0 +1,60 @@
+package quotes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// QuoteOption represents a functional option for quote requests
+type QuoteOption func(*client.Request)
+
+// WithProperties specifies which properties to return; repeated calls add to the list
+func WithProperties(properties []string) QuoteOption {
+	return func(req *client.Request) {
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
+	}
+}
+
+// WithPropertiesWithHistory specifies which properties to return with history
+func WithPropertiesWithHistory(properties []string) QuoteOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("propertiesWithHistory", strings.Join(properties, ","))
+	}
+}
+
+// WithAssociations specifies which associations to return; repeated calls add to the list
+func WithAssociations(associations []string) QuoteOption {
+	return func(req *client.Request) {
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
+	}
+}
+
+// WithLimit sets the maximum number of results per page
+func WithLimit(limit int) QuoteOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("limit", fmt.Sprintf("%d", limit))
+	}
+}
+
+// WithAfter sets the paging cursor
+func WithAfter(after string) QuoteOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("after", after)
+	}
+}
+
+// WithArchived includes archived quotes
+func WithArchived() QuoteOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("archived", "true")
+	}
+}
+
+// WithIDProperty specifies a unique identifier property to use instead of ID
+func WithIDProperty(property string) QuoteOption {
+	return func(req *client.Request) {
+		req.AddQueryParam("idProperty", property)
+	}
+}
@@ -0,0 +1,21 @@
+package schemas
+
+import "context"
+
+// API is the set of schemas client methods, implemented by *Client
+//
+// Code that calls the schemas API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	GetAllSchemas(ctx context.Context, opts ...SchemaOption) (*GetAllSchemasResponse, error)
+	ListSchemaNames(ctx context.Context, opts ...SchemaOption) ([]SchemaName, error)
+	GetExistingSchema(ctx context.Context, objectType string) (*Schema, error)
+	CreateNewSchema(ctx context.Context, input *CreateNewSchemaInput) (*Schema, error)
+	CreateNewAssociationSchema(ctx context.Context, objectType string, input *CreateNewAssociationSchemaInput) (*CreateNewAssociationSchemaResponse, error)
+	UpdateSchema(ctx context.Context, objectType string, input *UpdateSchemaInput) (*Schema, error)
+	DeleteSchema(ctx context.Context, objectType string, opts ...SchemaOption) error
+	RemoveAssociationSchema(ctx context.Context, objectType, associationIdentifier string) error
+	ResolveObjectTypeID(ctx context.Context, name string) (string, error)
+}
+
+var _ API = (*Client)(nil)
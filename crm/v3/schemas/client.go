@@ -3,6 +3,7 @@ package schemas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
@@ -11,12 +12,16 @@ import (
 
 type Client struct {
 	apiClient *client.Client
+	typeIDs   *typeIDCache
 }
 
 // NewClient creates a new schemas client
 func NewClient(apiClient *client.Client) *Client {
 	return &Client{
 		apiClient: apiClient,
+		typeIDs: &typeIDCache{
+			byName: make(map[string]string),
+		},
 	}
 }
 
@@ -42,8 +47,8 @@ func (c *Client) GetAllSchemas(ctx context.Context, opts ...SchemaOption) (*GetA
 	}
 
 	var schemas GetAllSchemasResponse
-	if err := tools.NewRequiredTagStruct(&schemas).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schemas response: %w", err)
+	if err := tools.NewRequiredTagStruct(&schemas).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schemas response: %w", tools.DecodeError(err, resp))
 	}
 
 	if len(schemas.Results) == 0 {
@@ -53,6 +58,38 @@ func (c *Client) GetAllSchemas(ctx context.Context, opts ...SchemaOption) (*GetA
 	return &schemas, nil
 }
 
+// ListSchemaNames lists the custom object types of the account without their property definitions, e.g. to fill
+// a type selector
+//
+// HubSpot has no way to request only some schema fields, so the response is the same one GetAllSchemas reads, but
+// only the fields of SchemaName are decoded. Unlike GetAllSchemas, an account without custom objects is not an error
+//
+// opts:
+// WithArchived
+func (c *Client) ListSchemaNames(ctx context.Context, opts ...SchemaOption) ([]SchemaName, error) {
+	req := client.NewRequest("GET", "/crm-object-schemas/v3/schemas")
+	req.WithContext(ctx)
+	req.WithResourceType("schemas")
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var names struct {
+		Results []SchemaName `json:"results"`
+	}
+	if err := json.Unmarshal(resp.Body, &names); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schemas response: %w", tools.DecodeError(err, resp))
+	}
+
+	return names.Results, nil
+}
+
 // GetExistingSchema gets an existing schema by object type
 func (c *Client) GetExistingSchema(ctx context.Context, objectType string) (*Schema, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm-object-schemas/v3/schemas/%s", objectType))
@@ -65,15 +102,30 @@ func (c *Client) GetExistingSchema(ctx context.Context, objectType string) (*Sch
 	}
 
 	var schema Schema
-	if err := tools.NewRequiredTagStruct(&schema).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schema response: %w", err)
+	if err := tools.NewRequiredTagStruct(&schema).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &schema, nil
 }
 
 // CreateNewSchema creates a new object schema
+//
+// A *client.RequiredFieldError is returned without sending the request if a required field of input is empty, and a
+// *FormulaError if the formula of a calculated property is malformed
 func (c *Client) CreateNewSchema(ctx context.Context, input *CreateNewSchemaInput) (*Schema, error) {
+	if err := tools.ValidateRequired(input); err != nil {
+		return nil, err
+	}
+	for _, property := range input.Properties {
+		if !property.Calculated {
+			continue
+		}
+		if err := ValidateFormula(property.CalculationFormula); err != nil {
+			return nil, fmt.Errorf("property %s: %w", property.Name, err)
+		}
+	}
+
 	req := client.NewRequest("POST", "/crm-object-schemas/v3/schemas")
 	req.WithContext(ctx)
 	req.WithResourceType("schemas")
@@ -85,15 +137,21 @@ func (c *Client) CreateNewSchema(ctx context.Context, input *CreateNewSchemaInpu
 	}
 
 	var schema Schema
-	if err := tools.NewRequiredTagStruct(&schema).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schema response: %w", err)
+	if err := tools.NewRequiredTagStruct(&schema).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &schema, nil
 }
 
 // CreateNewAssociationSchema creates a new object assocation
+//
+// A *client.RequiredFieldError is returned without sending the request if a required field of input is empty
 func (c *Client) CreateNewAssociationSchema(ctx context.Context, objectType string, input *CreateNewAssociationSchemaInput) (*CreateNewAssociationSchemaResponse, error) {
+	if err := tools.ValidateRequired(input); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm-object-schemas/v3/schemas/%s/associations", objectType))
 	req.WithContext(ctx)
 	req.WithResourceType("schemas")
@@ -105,14 +163,16 @@ func (c *Client) CreateNewAssociationSchema(ctx context.Context, objectType stri
 	}
 
 	var assocResp CreateNewAssociationSchemaResponse
-	if err := tools.NewRequiredTagStruct(&assocResp).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schema response: %w", err)
+	if err := tools.NewRequiredTagStruct(&assocResp).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &assocResp, nil
 }
 
-// UpdateSchema udpates an existing object schema
+// UpdateSchema updates an existing object schema
+//
+// Only the fields set in input are sent, see UpdateSchemaInput
 func (c *Client) UpdateSchema(ctx context.Context, objectType string, input *UpdateSchemaInput) (*Schema, error) {
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm-object-schemas/v3/schemas/%s", objectType))
 	req.WithContext(ctx)
@@ -125,8 +185,8 @@ func (c *Client) UpdateSchema(ctx context.Context, objectType string, input *Upd
 	}
 
 	var schema Schema
-	if err := tools.NewRequiredTagStruct(&schema).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schema response: %w", err)
+	if err := tools.NewRequiredTagStruct(&schema).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &schema, nil
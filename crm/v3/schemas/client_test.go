@@ -2,12 +2,14 @@ package schemas
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -144,6 +146,59 @@ func TestGetAllSchemas_NoResults(t *testing.T) {
 	assert.Contains(t, err.Error(), "no schemas found")
 }
 
+// TestListSchemaNames_Success tests listing custom object type names without their properties
+func TestListSchemaNames_Success(t *testing.T) {
+	schemasJSON := `{
+		"results": [
+			{
+				"id": "2-123456",
+				"name": "pets",
+				"fullyQualifiedName": "p12345_pets",
+				"objectTypeId": "2-123456",
+				"labels": {"singular": "Pet", "plural": "Pets"},
+				"properties": [{"name": "breed", "label": "Breed", "type": "string", "fieldType": "text"}]
+			},
+			{
+				"id": "2-654321",
+				"name": "vehicles",
+				"fullyQualifiedName": "p12345_vehicles",
+				"objectTypeId": "2-654321",
+				"labels": {"singular": "Vehicle", "plural": "Vehicles"}
+			}
+		]
+	}`
+
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm-object-schemas/v3/schemas", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("archived"))
+		respondJSON(w, http.StatusOK, schemasJSON)
+	})
+	defer server.Close()
+
+	names, err := schemasClient.ListSchemaNames(context.Background(), WithArchived())
+
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+	assert.Equal(t, "pets", names[0].Name)
+	assert.Equal(t, "p12345_pets", names[0].FullyQualifiedName)
+	assert.Equal(t, "2-123456", names[0].ObjectTypeID)
+	assert.Equal(t, "Pets", names[0].Labels.Plural)
+	assert.Equal(t, "Vehicle", names[1].Labels.Singular)
+}
+
+// TestListSchemaNames_NoResults tests that an account without custom objects is not an error
+func TestListSchemaNames_NoResults(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"results": []}`)
+	})
+	defer server.Close()
+
+	names, err := schemasClient.ListSchemaNames(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
 // TestGetAllSchemas_InvalidJSON tests invalid JSON response
 func TestGetAllSchemas_InvalidJSON(t *testing.T) {
 	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -296,6 +351,151 @@ func TestCreateNewSchema_Success(t *testing.T) {
 	assert.Equal(t, "custom_object", schema.Name)
 }
 
+// TestCreateNewSchema_CalculatedProperty tests that a property built with NewCalculatedProperty is sent with its formula
+func TestCreateNewSchema_CalculatedProperty(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		properties := body["properties"].([]any)
+		require.Len(t, properties, 1)
+		property := properties[0].(map[string]any)
+		assert.Equal(t, "weighted_value", property["name"])
+		assert.Equal(t, "number", property["type"])
+		assert.Equal(t, "calculation_equation", property["fieldType"])
+		assert.Equal(t, true, property["calculated"])
+		assert.Equal(t, "value * (probability / 100)", property["calculationFormula"])
+
+		respondJSON(w, http.StatusCreated, `{
+			"id": "2-123456",
+			"name": "opportunity",
+			"labels": {"singular": "Opportunity", "plural": "Opportunities"},
+			"requiredProperties": [],
+			"properties": [{"name": "weighted_value", "label": "Weighted value", "type": "number", "fieldType": "calculation_equation", "groupName": "", "description": "", "options": [], "calculated": true, "calculationFormula": "value * (probability / 100)"}],
+			"associations": [],
+			"archived": false,
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"updatedAt": "2024-01-01T00:00:00.000Z"
+		}`)
+	})
+	defer server.Close()
+
+	property, err := NewCalculatedProperty("weighted_value", "Weighted value", "value * (probability / 100)")
+	require.NoError(t, err)
+
+	schema, err := schemasClient.CreateNewSchema(context.Background(), &CreateNewSchemaInput{
+		Name:               "opportunity",
+		RequiredProperties: []string{},
+		AssociatedObjects:  []string{},
+		Properties:         []Property{property},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, schema.Properties, 1)
+	assert.Equal(t, "value * (probability / 100)", schema.Properties[0].CalculationFormula)
+}
+
+// TestCreateNewSchema_InvalidFormula tests that a malformed formula is rejected before the request is sent
+func TestCreateNewSchema_InvalidFormula(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	_, err := schemasClient.CreateNewSchema(context.Background(), &CreateNewSchemaInput{
+		Name:               "opportunity",
+		RequiredProperties: []string{},
+		AssociatedObjects:  []string{},
+		Properties: []Property{{
+			Name:               "weighted_value",
+			Calculated:         true,
+			CalculationFormula: "value * (probability / 100",
+		}},
+	})
+
+	var formulaErr *FormulaError
+	require.ErrorAs(t, err, &formulaErr)
+	assert.Equal(t, 8, formulaErr.Position)
+	assert.Contains(t, err.Error(), "weighted_value")
+}
+
+// TestValidateFormula tests formula validation
+func TestValidateFormula(t *testing.T) {
+	tests := []struct {
+		name     string
+		formula  string
+		position int
+		valid    bool
+	}{
+		{name: "Simple", formula: "amount * 2", valid: true},
+		{name: "Nested", formula: "if(is_present(amount), (amount - cost) / amount, 0)", valid: true},
+		{name: "Parenthesis in string", formula: `concatenate(name, " (", code, ")")`, valid: true},
+		{name: "Empty", formula: "  ", position: -1},
+		{name: "Unclosed", formula: "(amount", position: 0},
+		{name: "Unexpected close", formula: "amount)", position: 6},
+		{name: "Unterminated string", formula: `concatenate(name, "x)`, position: 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFormula(tt.formula)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			var formulaErr *FormulaError
+			require.ErrorAs(t, err, &formulaErr)
+			assert.Equal(t, tt.position, formulaErr.Position)
+		})
+	}
+
+	_, err := NewCalculatedProperty("bad", "Bad", "")
+	assert.Error(t, err)
+}
+
+// TestCreateNewSchema_SensitiveProperty tests that sensitivity set through NewSensitiveProperty is sent
+func TestCreateNewSchema_SensitiveProperty(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		properties := body["properties"].([]any)
+		require.Len(t, properties, 1)
+		property := properties[0].(map[string]any)
+		assert.Equal(t, "national_id", property["name"])
+		assert.Equal(t, "National ID", property["label"])
+		assert.Equal(t, "string", property["type"])
+		assert.Equal(t, "text", property["fieldType"])
+		assert.Equal(t, "highly_sensitive", property["dataSensitivity"])
+		assert.Equal(t, []any{"government_id"}, property["sensitiveDataCategories"])
+
+		respondJSON(w, http.StatusCreated, `{
+			"id": "2-123456",
+			"name": "patient",
+			"labels": {"singular": "Patient", "plural": "Patients"},
+			"requiredProperties": [],
+			"properties": [],
+			"associations": [],
+			"archived": false,
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"updatedAt": "2024-01-01T00:00:00.000Z"
+		}`)
+	})
+	defer server.Close()
+
+	property := NewSensitiveProperty("national_id", "National ID", HighlySensitive)
+	property.SensitiveDataCategories = []string{"government_id"}
+
+	_, err := schemasClient.CreateNewSchema(context.Background(), &CreateNewSchemaInput{
+		Name:               "patient",
+		RequiredProperties: []string{},
+		AssociatedObjects:  []string{},
+		Properties:         []Property{property},
+	})
+
+	require.NoError(t, err)
+}
+
 // TestCreateNewSchema_ValidationError tests validation error
 func TestCreateNewSchema_ValidationError(t *testing.T) {
 	errorJSON := `{
@@ -310,7 +510,7 @@ func TestCreateNewSchema_ValidationError(t *testing.T) {
 	defer server.Close()
 
 	input := &CreateNewSchemaInput{
-		Name:               "",
+		Name:               "invalid name!",
 		RequiredProperties: []string{},
 		AssociatedObjects:  []string{},
 		Properties:         []Property{},
@@ -322,6 +522,27 @@ func TestCreateNewSchema_ValidationError(t *testing.T) {
 	assert.Nil(t, schema)
 }
 
+// TestCreateNewSchema_MissingRequiredField tests that a missing required field fails before the request is sent
+func TestCreateNewSchema_MissingRequiredField(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	input := &CreateNewSchemaInput{
+		RequiredProperties: []string{},
+		AssociatedObjects:  []string{},
+		Properties:         []Property{},
+	}
+
+	schema, err := schemasClient.CreateNewSchema(context.Background(), input)
+
+	var requiredErr *client.RequiredFieldError
+	require.ErrorAs(t, err, &requiredErr)
+	assert.Equal(t, "Name", requiredErr.Field)
+	assert.Nil(t, schema)
+}
+
 // TestCreateNewSchema_InvalidJSON tests invalid JSON response
 func TestCreateNewSchema_InvalidJSON(t *testing.T) {
 	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -462,6 +683,68 @@ func TestUpdateSchema_Success(t *testing.T) {
 	assert.Equal(t, "Updated Object", schema.Labels.Singular)
 }
 
+// TestUpdateSchema_DisplayProperties tests that display and searchable properties are sent and unset fields are not
+func TestUpdateSchema_DisplayProperties(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"primaryDisplayProperty":     "pet_name",
+			"secondaryDisplayProperties": []any{"breed", "owner_email"},
+			"searchableProperties":       []any{"pet_name", "breed"},
+			"requiredProperties":         []any{},
+		}, body)
+
+		respondJSON(w, http.StatusOK, `{
+			"id": "2-123456",
+			"name": "pets",
+			"labels": {"singular": "Pet", "plural": "Pets"},
+			"requiredProperties": [],
+			"properties": [],
+			"associations": [],
+			"primaryDisplayProperty": "pet_name",
+			"secondaryDisplayProperties": ["breed", "owner_email"],
+			"searchableProperties": ["pet_name", "breed"]
+		}`)
+	})
+	defer server.Close()
+
+	schema, err := schemasClient.UpdateSchema(context.Background(), "pets", &UpdateSchemaInput{
+		PrimaryDisplayProperty:     "pet_name",
+		SecondaryDisplayProperties: []string{"breed", "owner_email"},
+		SearchableProperties:       []string{"pet_name", "breed"},
+		RequiredProperties:         []string{},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pet_name", schema.PrimaryDisplayProperty)
+	assert.Equal(t, []string{"breed", "owner_email"}, schema.SecondaryDisplayProperties)
+	assert.Equal(t, []string{"pet_name", "breed"}, schema.SearchableProperties)
+}
+
+// TestUpdateSchemaInput_Booleans tests that ClearDescription and Restorable are only sent when set, including false
+func TestUpdateSchemaInput_Booleans(t *testing.T) {
+	yes, no := true, false
+	data, err := json.Marshal(&UpdateSchemaInput{ClearDescription: &yes, Restorable: &yes})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"clearDescription": true, "restorable": true}`, string(data))
+
+	data, err = json.Marshal(&UpdateSchemaInput{Restorable: &no})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"restorable": false}`, string(data))
+
+	data, err = json.Marshal(&UpdateSchemaInput{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+
+	input := &UpdateSchemaInput{}
+	input.Labels.Singular = "Pet"
+	input.Labels.Plural = "Pets"
+	data, err = json.Marshal(input)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"labels": {"singular": "Pet", "plural": "Pets"}}`, string(data))
+}
+
 // TestUpdateSchema_NotFound tests 404 error on update
 func TestUpdateSchema_NotFound(t *testing.T) {
 	errorJSON := `{
@@ -581,3 +864,81 @@ func TestRemoveAssociationSchema_NotFound(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+// TestResolveObjectTypeID_Success tests resolving names through the schemas endpoint with caching
+func TestResolveObjectTypeID_Success(t *testing.T) {
+	requests := 0
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/crm-object-schemas/v3/schemas/custom_object", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{
+			"id": "123456",
+			"name": "custom_object",
+			"objectTypeId": "2-123456",
+			"labels": {"singular": "Custom Object", "plural": "Custom Objects"},
+			"requiredProperties": ["name"],
+			"properties": [{"name": "name"}],
+			"associations": []
+		}`)
+	})
+	defer server.Close()
+
+	for range 2 {
+		typeID, err := schemasClient.ResolveObjectTypeID(context.Background(), "custom_object")
+		require.NoError(t, err)
+		assert.Equal(t, "2-123456", typeID)
+	}
+	assert.Equal(t, 1, requests)
+
+	typeID, err := schemasClient.ResolveObjectTypeID(context.Background(), "0-1")
+	require.NoError(t, err)
+	assert.Equal(t, "0-1", typeID)
+	assert.Equal(t, 1, requests)
+
+	typeID, err = schemasClient.ResolveObjectTypeID(context.Background(), "contacts")
+	require.NoError(t, err)
+	assert.Equal(t, "0-1", typeID, "standard objects are resolved without the schemas endpoint")
+	assert.Equal(t, 1, requests)
+}
+
+// TestResolveObjectTypeID_PerTenant tests that a custom object's type ID resolved for one account isn't reused for
+// another
+func TestResolveObjectTypeID_PerTenant(t *testing.T) {
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		id := "111"
+		if r.Header.Get("Authorization") == "Bearer tenant-b" {
+			id = "222"
+		}
+		respondJSON(w, http.StatusOK, `{"id": "`+id+`", "name": "custom_object", "objectTypeId": "2-`+id+`",
+			"labels": {"singular": "Custom", "plural": "Customs"}, "requiredProperties": [], "properties": [], "associations": []}`)
+	})
+	defer server.Close()
+
+	for tenant, want := range map[string]string{"tenant-a": "2-111", "tenant-b": "2-222"} {
+		ctx := client.ContextWithAccessToken(context.Background(), tenant)
+		typeID, err := schemasClient.ResolveObjectTypeID(ctx, "custom_object")
+		require.NoError(t, err)
+		assert.Equal(t, want, typeID)
+	}
+}
+
+// TestResolveObjectTypeID_NotFound tests that unknown names return an error and aren't cached
+func TestResolveObjectTypeID_NotFound(t *testing.T) {
+	requests := 0
+	server, schemasClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusNotFound, `{"status": "error", "message": "Unable to find object type", "category": "OBJECT_NOT_FOUND"}`)
+	})
+	defer server.Close()
+
+	for range 2 {
+		_, err := schemasClient.ResolveObjectTypeID(context.Background(), "missing")
+		require.Error(t, err)
+	}
+	assert.Equal(t, 2, requests)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
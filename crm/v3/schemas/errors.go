@@ -1 +1,19 @@
 package schemas
+
+import "fmt"
+
+// FormulaError is returned when the formula of a calculated property is malformed
+//
+// Position is the byte offset in Formula where the problem was found, or -1 if it applies to the whole formula
+type FormulaError struct {
+	Formula  string
+	Position int
+	Message  string
+}
+
+func (e *FormulaError) Error() string {
+	if e.Position < 0 {
+		return fmt.Sprintf("invalid calculation formula: %s", e.Message)
+	}
+	return fmt.Sprintf("invalid calculation formula at position %d: %s", e.Position, e.Message)
+}
@@ -1,5 +1,7 @@
 package schemas
 
+import "strings"
+
 type DataSensitivity string
 
 const (
@@ -84,10 +86,79 @@ type Property struct {
 	DataSensitivity          *DataSensitivity   `json:"dataSensitivity"`
 	ArchivedAt               string             `json:"archivedAt"`
 	ReferencedObjectType     string             `json:"referencedObjectType"`
-	CalculationFormula       string             `json:"CalculationFormula"`
+	CalculationFormula       string             `json:"calculationFormula,omitempty"`
 	UpdatedUserID            string             `json:"updatedUserID"`
 }
 
+// NewSensitiveProperty builds a single-line text property marked with the given data sensitivity level
+//
+// Set SensitiveDataCategories or any other field on the returned value before adding it to CreateNewSchemaInput
+func NewSensitiveProperty(name, label string, level DataSensitivity) Property {
+	return Property{
+		Name:            name,
+		Label:           label,
+		Type:            "string",
+		FieldType:       "text",
+		Options:         []Option{},
+		DataSensitivity: &level,
+	}
+}
+
+// NewCalculatedProperty builds a number property whose value HubSpot calculates from formula
+//
+// formula uses HubSpot's calculation syntax, e.g. "amount * hs_deal_probability". A *FormulaError is returned if
+// formula is empty or its parentheses or string literals aren't balanced; HubSpot validates property references
+// itself when the schema is created
+func NewCalculatedProperty(name, label, formula string) (Property, error) {
+	if err := ValidateFormula(formula); err != nil {
+		return Property{}, err
+	}
+	return Property{
+		Name:               name,
+		Label:              label,
+		Type:               "number",
+		FieldType:          "calculation_equation",
+		Options:            []Option{},
+		Calculated:         true,
+		CalculationFormula: formula,
+	}, nil
+}
+
+// ValidateFormula checks that formula is non-empty and that its parentheses and string literals are balanced
+func ValidateFormula(formula string) error {
+	if strings.TrimSpace(formula) == "" {
+		return &FormulaError{Formula: formula, Position: -1, Message: "formula is empty"}
+	}
+
+	var open []int
+	var quote rune
+	quoteStart := -1
+	for i, r := range formula {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote, quoteStart = r, i
+		case r == '(':
+			open = append(open, i)
+		case r == ')':
+			if len(open) == 0 {
+				return &FormulaError{Formula: formula, Position: i, Message: "unexpected closing parenthesis"}
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if quote != 0 {
+		return &FormulaError{Formula: formula, Position: quoteStart, Message: "unterminated string literal"}
+	}
+	if len(open) > 0 {
+		return &FormulaError{Formula: formula, Position: open[len(open)-1], Message: "unclosed parenthesis"}
+	}
+	return nil
+}
+
 type Option struct {
 	Hidden       bool   `json:"hidden" required:"yes"`
 	Label        string `json:"label" required:"yes"`
@@ -96,6 +167,17 @@ type Option struct {
 	Description  string `json:"description" `
 }
 
+// SchemaName identifies a custom object type, as listed by ListSchemaNames
+type SchemaName struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	ObjectTypeID       string `json:"objectTypeId"`
+	Labels             struct {
+		Singular string `json:"singular"`
+		Plural   string `json:"plural"`
+	} `json:"labels"`
+}
+
 type GetAllSchemasResponse struct {
 	Results []Schema `json:"results" required:"yes"`
 }
@@ -130,16 +212,21 @@ type CreateNewAssociationSchemaResponse struct {
 	UpdatedAt        string `json:"updatedAt"`
 }
 
+// UpdateSchemaInput holds the schema fields to change; unset fields are left out of the request and keep their value
+//
+// A nil slice leaves that list unchanged, while an empty non-nil slice clears it. Likewise a nil ClearDescription or
+// Restorable is not sent, while a pointer to false is. Set ClearDescription to true to remove the description, since
+// an empty Description is not sent
 type UpdateSchemaInput struct {
-	SecondaryDisplayProperties []string `json:"secondaryDisplayProperties"`
-	RequiredProperties         []string `json:"requiredProperties"`
-	SearchableProperties       []string `json:"searchableProperties"`
-	ClearDescription           bool     `json:"clearDescription"`
-	PrimaryDisplayProperty     string   `json:"primaryDisplayProperty"`
-	Description                string   `json:"description"`
-	Restorable                 bool     `json:"restorable"`
+	SecondaryDisplayProperties []string `json:"secondaryDisplayProperties,omitzero"`
+	RequiredProperties         []string `json:"requiredProperties,omitzero"`
+	SearchableProperties       []string `json:"searchableProperties,omitzero"`
+	ClearDescription           *bool    `json:"clearDescription,omitempty"`
+	PrimaryDisplayProperty     string   `json:"primaryDisplayProperty,omitempty"`
+	Description                string   `json:"description,omitempty"`
+	Restorable                 *bool    `json:"restorable,omitempty"`
 	Labels                     struct {
 		Singular string `json:"singular"`
 		Plural   string `json:"plural"`
-	}
+	} `json:"labels,omitzero"`
 }
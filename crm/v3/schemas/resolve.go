@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
+)
+
+// objectTypeIDPattern matches object type IDs such as 0-1 for contacts or 2-123456 for a custom object
+var objectTypeIDPattern = regexp.MustCompile(`^\d+-\d+$`)
+
+// typeIDCache remembers the object type ID of each object name so names are resolved with one request each
+//
+// Custom object type IDs differ between accounts, so names are cached per client.TenantKey
+type typeIDCache struct {
+	mu     sync.Mutex
+	byName map[string]string
+}
+
+// ResolveObjectTypeID returns the objectTypeId of the object named name, e.g. 0-1 for contacts or 2-123456 for a
+// custom object
+//
+// Standard objects such as contacts are resolved from the objecttypes package without a request. Custom object names
+// are looked up with GetExistingSchema on first use and the result is cached for the life of the client, per account
+// when ctx carries a token from client.ContextWithAccessToken, so the IDs needed by list filters and v4 associations
+// don't have to be hardcoded. A name that is already an object type ID is returned as-is without a request
+func (c *Client) ResolveObjectTypeID(ctx context.Context, name string) (string, error) {
+	if objectTypeIDPattern.MatchString(name) {
+		return name, nil
+	}
+	if typeID, ok := objecttypes.TypeID(name); ok {
+		return typeID, nil
+	}
+
+	key := client.TenantKey(ctx) + ":" + name
+	c.typeIDs.mu.Lock()
+	typeID, ok := c.typeIDs.byName[key]
+	c.typeIDs.mu.Unlock()
+	if ok {
+		return typeID, nil
+	}
+
+	schema, err := c.GetExistingSchema(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve object type ID of %q: %w", name, err)
+	}
+	if schema.ObjectTypeID == "" {
+		return "", fmt.Errorf("schema of %q has no object type ID", name)
+	}
+
+	c.typeIDs.mu.Lock()
+	c.typeIDs.byName[key] = schema.ObjectTypeID
+	c.typeIDs.mu.Unlock()
+
+	return schema.ObjectTypeID, nil
+}
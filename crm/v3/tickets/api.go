@@ -0,0 +1,24 @@
+package tickets
+
+import "context"
+
+// API is the set of tickets client methods, implemented by *Client
+//
+// Code that calls the tickets API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	ListTickets(ctx context.Context, opts ...TicketOption) (*ListTicketsResponse, error)
+	CreateTicket(ctx context.Context, input *CreateTicketInput) (*CreateTicketResponse, error)
+	ReadTicket(ctx context.Context, ticketID string, opts ...TicketOption) (*Ticket, error)
+	UpdateTicket(ctx context.Context, ticketID string, input *UpdateTicketInput, opts ...TicketOption) (*Ticket, error)
+	ArchiveTicket(ctx context.Context, ticketID string) error
+	MergeTwoTickets(ctx context.Context, input *MergeTwoTicketsInput) error
+	BatchReadTickets(ctx context.Context, input *BatchReadTicketsInput, opts ...TicketOption) (*BatchTicketsResponse, error)
+	BatchCreateTickets(ctx context.Context, input *BatchCreateTicketsInput) (*BatchTicketsResponse, error)
+	BatchUpdateTickets(ctx context.Context, input *BatchUpdateTicketsInput) (*BatchTicketsResponse, error)
+	BatchCreateOrUpdateTickets(ctx context.Context, input *BatchCreateOrUpdateTicketsInput) (*BatchTicketsResponse, error)
+	BatchArchiveTickets(ctx context.Context, input *BatchArchiveTicketsInput) (*BatchTicketsResponse, error)
+	SearchTickets(ctx context.Context, input *SearchTicketsInput) (*SearchTicketsResponse, error)
+}
+
+var _ API = (*Client)(nil)
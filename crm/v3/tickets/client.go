@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/crm/v3/objecttypes"
 	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
 )
 
@@ -35,7 +37,8 @@ func NewClient(apiClient *client.Client) *Client {
 func (c *Client) ListTickets(ctx context.Context, opts ...TicketOption) (*ListTicketsResponse, error) {
 	req := client.NewRequest("GET", "/crm/v3/objects/tickets")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
+	req.WithPaging()
 
 	for _, opt := range opts {
 		opt(req)
@@ -48,7 +51,7 @@ func (c *Client) ListTickets(ctx context.Context, opts ...TicketOption) (*ListTi
 
 	var tickets ListTicketsResponse
 	if err := json.Unmarshal(resp.Body, &tickets); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal tickets response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	if len(tickets.Results) == 0 {
@@ -60,10 +63,20 @@ func (c *Client) ListTickets(ctx context.Context, opts ...TicketOption) (*ListTi
 
 // CreateTicket creates a new ticket
 func (c *Client) CreateTicket(ctx context.Context, input *CreateTicketInput) (*CreateTicketResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Tickets, input.Properties)
+
+	if err := tools.ValidateRequired(&withDefaults); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Tickets)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -72,7 +85,7 @@ func (c *Client) CreateTicket(ctx context.Context, input *CreateTicketInput) (*C
 
 	var ticket CreateTicketResponse
 	if err := json.Unmarshal(resp.Body, &ticket); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &ticket, nil
@@ -89,7 +102,7 @@ func (c *Client) CreateTicket(ctx context.Context, input *CreateTicketInput) (*C
 func (c *Client) ReadTicket(ctx context.Context, ticketID string, opts ...TicketOption) (*Ticket, error) {
 	req := client.NewRequest("GET", fmt.Sprintf("/crm/v3/objects/tickets/%s", ticketID))
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 
 	for _, opt := range opts {
 		opt(req)
@@ -97,12 +110,12 @@ func (c *Client) ReadTicket(ctx context.Context, ticketID string, opts ...Ticket
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, client.ParseNotFoundError(err, objecttypes.Tickets, ticketID)
 	}
 
 	var ticket Ticket
 	if err := json.Unmarshal(resp.Body, &ticket); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &ticket, nil
@@ -113,9 +126,13 @@ func (c *Client) ReadTicket(ctx context.Context, ticketID string, opts ...Ticket
 // opts:
 // WithIDProperty
 func (c *Client) UpdateTicket(ctx context.Context, ticketID string, input *UpdateTicketInput, opts ...TicketOption) (*Ticket, error) {
+	if err := tools.ValidateRequired(input); err != nil {
+		return nil, err
+	}
+
 	req := client.NewRequest("PATCH", fmt.Sprintf("/crm/v3/objects/tickets/%s", ticketID))
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	for _, opt := range opts {
@@ -129,7 +146,7 @@ func (c *Client) UpdateTicket(ctx context.Context, ticketID string, input *Updat
 
 	var ticket Ticket
 	if err := json.Unmarshal(resp.Body, &ticket); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal ticket response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &ticket, nil
@@ -139,7 +156,7 @@ func (c *Client) UpdateTicket(ctx context.Context, ticketID string, input *Updat
 func (c *Client) ArchiveTicket(ctx context.Context, ticketID string) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v3/objects/tickets/%s", ticketID))
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 
 	_, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -151,9 +168,13 @@ func (c *Client) ArchiveTicket(ctx context.Context, ticketID string) error {
 
 // MergeTwoTickets merges two tickets together
 func (c *Client) MergeTwoTickets(ctx context.Context, input *MergeTwoTicketsInput) error {
+	if err := tools.ValidateRequired(input); err != nil {
+		return err
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/merge")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -163,7 +184,7 @@ func (c *Client) MergeTwoTickets(ctx context.Context, input *MergeTwoTicketsInpu
 
 	var ticket Ticket
 	if err := json.Unmarshal(resp.Body, &ticket); err != nil {
-		return fmt.Errorf("failed to unmarshal ticket response: %w", err)
+		return fmt.Errorf("failed to unmarshal ticket response: %w", tools.DecodeError(err, resp))
 	}
 
 	return nil
@@ -178,7 +199,7 @@ func (c *Client) MergeTwoTickets(ctx context.Context, input *MergeTwoTicketsInpu
 func (c *Client) BatchReadTickets(ctx context.Context, input *BatchReadTicketsInput, opts ...TicketOption) (*BatchTicketsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/batch/read")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	for _, opt := range opts {
@@ -191,8 +212,8 @@ func (c *Client) BatchReadTickets(ctx context.Context, input *BatchReadTicketsIn
 	}
 
 	var obj BatchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &obj, nil
@@ -200,10 +221,19 @@ func (c *Client) BatchReadTickets(ctx context.Context, input *BatchReadTicketsIn
 
 // BatchCreateTickets Create a batch of tickets. The inputs array can contain a properties object to define property values for the ticket, along with an associations array to define associations with other CRM records.
 func (c *Client) BatchCreateTickets(ctx context.Context, input *BatchCreateTicketsInput) (*BatchTicketsResponse, error) {
+	if input == nil {
+		return nil, fmt.Errorf("input is required but is nil")
+	}
+	withDefaults := *input
+	withDefaults.Inputs = slices.Clone(input.Inputs)
+	for i := range withDefaults.Inputs {
+		withDefaults.Inputs[i].Properties = c.apiClient.ApplyCreateDefaults(objecttypes.Tickets, withDefaults.Inputs[i].Properties)
+	}
+
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/batch/create")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
-	req.WithBody(input)
+	req.WithResourceType(objecttypes.Tickets)
+	req.WithBody(&withDefaults)
 
 	resp, err := c.apiClient.Do(ctx, req)
 	if err != nil {
@@ -211,8 +241,8 @@ func (c *Client) BatchCreateTickets(ctx context.Context, input *BatchCreateTicke
 	}
 
 	var obj BatchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &obj, nil
@@ -222,7 +252,7 @@ func (c *Client) BatchCreateTickets(ctx context.Context, input *BatchCreateTicke
 func (c *Client) BatchUpdateTickets(ctx context.Context, input *BatchUpdateTicketsInput) (*BatchTicketsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/batch/update")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -231,8 +261,8 @@ func (c *Client) BatchUpdateTickets(ctx context.Context, input *BatchUpdateTicke
 	}
 
 	var obj BatchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &obj, nil
@@ -242,7 +272,7 @@ func (c *Client) BatchUpdateTickets(ctx context.Context, input *BatchUpdateTicke
 func (c *Client) BatchCreateOrUpdateTickets(ctx context.Context, input *BatchCreateOrUpdateTicketsInput) (*BatchTicketsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/batch/createOrUpdate")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -251,8 +281,8 @@ func (c *Client) BatchCreateOrUpdateTickets(ctx context.Context, input *BatchCre
 	}
 
 	var obj BatchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &obj, nil
@@ -262,7 +292,7 @@ func (c *Client) BatchCreateOrUpdateTickets(ctx context.Context, input *BatchCre
 func (c *Client) BatchArchiveTickets(ctx context.Context, input *BatchArchiveTicketsInput) (*BatchTicketsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/batch/archive")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -271,8 +301,8 @@ func (c *Client) BatchArchiveTickets(ctx context.Context, input *BatchArchiveTic
 	}
 
 	var obj BatchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&obj).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", err)
+	if err := tools.NewRequiredTagStruct(&obj).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal batch tickets response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &obj, nil
@@ -284,7 +314,8 @@ func (c *Client) BatchArchiveTickets(ctx context.Context, input *BatchArchiveTic
 func (c *Client) SearchTickets(ctx context.Context, input *SearchTicketsInput) (*SearchTicketsResponse, error) {
 	req := client.NewRequest("POST", "/crm/v3/objects/tickets/search")
 	req.WithContext(ctx)
-	req.WithResourceType("tickets")
+	req.WithResourceType(objecttypes.Tickets)
+	req.WithPaging()
 	req.WithBody(input)
 
 	resp, err := c.apiClient.Do(ctx, req)
@@ -293,8 +324,8 @@ func (c *Client) SearchTickets(ctx context.Context, input *SearchTicketsInput) (
 	}
 
 	var search SearchTicketsResponse
-	if err := tools.NewRequiredTagStruct(&search).UnmarhsalJSON(resp.Body); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal search response: %w", err)
+	if err := tools.NewRequiredTagStruct(&search).UnmarshalJSON(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &search, nil
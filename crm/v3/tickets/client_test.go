@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -350,6 +351,21 @@ func TestUpdateTicket_NotFound(t *testing.T) {
 	assert.Nil(t, ticket)
 }
 
+// TestUpdateTicket_MissingProperties tests that an update without properties fails before the request is sent
+func TestUpdateTicket_MissingProperties(t *testing.T) {
+	server, ticketsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	ticket, err := ticketsClient.UpdateTicket(context.Background(), "123456", &UpdateTicketInput{})
+
+	var requiredErr *client.RequiredFieldError
+	require.ErrorAs(t, err, &requiredErr)
+	assert.Equal(t, "Properties", requiredErr.Field)
+	assert.Nil(t, ticket)
+}
+
 // TestUpdateTicket_InvalidJSON tests invalid JSON response
 func TestUpdateTicket_InvalidJSON(t *testing.T) {
 	server, ticketsClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -731,3 +747,8 @@ func TestBatchArchiveTickets_Success(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, BatchStatus("COMPLETE"), result.Status)
 }
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
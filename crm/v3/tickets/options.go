@@ -23,10 +23,10 @@ func WithAfter(after string) TicketOption {
 	}
 }
 
-// WithProperties specifies which properties to retrieve
+// WithProperties specifies which properties to retrieve; repeated calls add to the list
 func WithProperties(properties []string) TicketOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("properties", strings.Join(properties, ","))
+		req.AppendQueryParam("properties", strings.Join(properties, ","))
 	}
 }
 
@@ -37,10 +37,10 @@ func WithPropertiesWithHistory(properties []string) TicketOption {
 	}
 }
 
-// WithAssociations specifies which associations to retrieve
+// WithAssociations specifies which associations to retrieve; repeated calls add to the list
 func WithAssociations(associations []string) TicketOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("associations", strings.Join(associations, ","))
+		req.AppendQueryParam("associations", strings.Join(associations, ","))
 	}
 }
 
@@ -0,0 +1,27 @@
+package associations
+
+import "context"
+
+// API is the set of associations client methods, implemented by *Client
+//
+// Code that calls the associations API can depend on API instead of *Client so its tests can inject a fake or a
+// mockgen-generated mock without an HTTP server. See Client for the documentation of each method
+type API interface {
+	CreateAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string, associationSpecs []AssociationSpec) (*AssociationResponse, error)
+	CreateLabeledAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID, label string) (*AssociationResponse, error)
+	DeleteAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string, associationSpecs []AssociationSpec) error
+	ListAssociations(ctx context.Context, fromObjectType, fromObjectID, toObjectType string, opts ...AssociationOption) (*ListAssociationsResponse, error)
+	ListAllAssociations(ctx context.Context, fromObjectType, fromObjectID, toObjectType string) ([]AssociatedObject, error)
+	GetAssociationDetails(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string) (*AssociatedObject, error)
+	AssociationExists(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string) (bool, error)
+	NewBatchAssociation(ctx context.Context, fromObjectType, toObjectType, fromID string, to []LabeledTarget) (*BatchAssociationInput, error)
+	BatchCreateAssociations(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput) error
+	BatchCreateAssociationsChunked(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput, opts ...ChunkedOption) error
+	BatchDeleteAssociations(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput) error
+	GetAssociationLabels(ctx context.Context, fromObjectType, toObjectType string) (*GetAssociationLabelsResponse, error)
+	GetAssociationDefinitions(ctx context.Context, fromObjectType, toObjectType string) (*GetAssociationDefinitionsResponse, error)
+	CreateAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, label string, inverse string) (*CreateAssociationLabelResponse, error)
+	DeleteAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, typeID int) error
+}
+
+var _ API = (*Client)(nil)
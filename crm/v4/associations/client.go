@@ -4,20 +4,40 @@ package associations
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/tools"
+)
+
+// batchLimit is the maximum number of inputs HubSpot accepts in a single v4 batch request
+const batchLimit = 100
+
+const (
+	// MaxListAssociationsPageSize is the largest page size HubSpot accepts when listing associations
+	MaxListAssociationsPageSize = 500
+
+	// MaxListAssociationsPages is the most pages ListAllAssociations reads before giving up
+	MaxListAssociationsPages = 1000
 )
 
 // Client represents the Associations API client
 type Client struct {
 	apiClient *client.Client
+	labels    *labelCache
 }
 
 // NewClient creates a new associations client
 func NewClient(apiClient *client.Client) *Client {
 	return &Client{
 		apiClient: apiClient,
+		labels: &labelCache{
+			byPair: make(map[string][]AssociationLabel),
+		},
 	}
 }
 
@@ -36,12 +56,28 @@ func (c *Client) CreateAssociation(ctx context.Context, fromObjectType, fromObje
 
 	var assocResp AssociationResponse
 	if err := json.Unmarshal(resp.Body, &assocResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal association response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal association response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &assocResp, nil
 }
 
+// CreateLabeledAssociation associates two objects using the association label named label, e.g. "Decision Maker"
+//
+// The label is resolved to its association type ID through GetAssociationLabels. Labels are cached per object type
+// pair for the lifetime of the client; a *LabelNotFoundError is returned if no label between the two types matches
+// exactly
+func (c *Client) CreateLabeledAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID, label string) (*AssociationResponse, error) {
+	found, err := c.resolveLabel(ctx, fromObjectType, toObjectType, label)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateAssociation(ctx, fromObjectType, fromObjectID, toObjectType, toObjectID, []AssociationSpec{
+		{AssociationCategory: found.Category, AssociationTypeID: found.TypeID},
+	})
+}
+
 // DeleteAssociation removes an association between two objects
 func (c *Client) DeleteAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string, associationSpecs []AssociationSpec) error {
 	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v4/objects/%s/%s/associations/%s/%s",
@@ -60,6 +96,7 @@ func (c *Client) ListAssociations(ctx context.Context, fromObjectType, fromObjec
 		fromObjectType, fromObjectID, toObjectType))
 	req.WithContext(ctx)
 	req.WithResourceType("associations")
+	req.WithPaging()
 
 	for _, opt := range opts {
 		opt(req)
@@ -72,14 +109,157 @@ func (c *Client) ListAssociations(ctx context.Context, fromObjectType, fromObjec
 
 	var listResp ListAssociationsResponse
 	if err := json.Unmarshal(resp.Body, &listResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal associations response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal associations response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &listResp, nil
 }
 
+// ListAllAssociations retrieves every association from an object to toObjectType, walking all pages
+//
+// Pages are requested with the maximum page size, following paging.next until it is absent. To guard against a
+// server that never stops paging, it stops after MaxListAssociationsPages pages, or when a page repeats the previous
+// cursor, and returns the associations read so far with an error wrapping ErrTooManyPages.
+func (c *Client) ListAllAssociations(ctx context.Context, fromObjectType, fromObjectID, toObjectType string) ([]AssociatedObject, error) {
+	var results []AssociatedObject
+	err := c.walkAssociations(ctx, fromObjectType, fromObjectID, toObjectType, func(page []AssociatedObject) bool {
+		results = append(results, page...)
+		return true
+	})
+	if err != nil && !errors.Is(err, ErrTooManyPages) {
+		return nil, err
+	}
+	return results, err
+}
+
+// walkAssociations calls fn with each page of associations from an object to toObjectType, as ListAllAssociations
+// reads them, until fn returns false or the pages run out
+func (c *Client) walkAssociations(ctx context.Context, fromObjectType, fromObjectID, toObjectType string, fn func([]AssociatedObject) bool) error {
+	var after string
+	for range MaxListAssociationsPages {
+		opts := []AssociationOption{WithLimit(MaxListAssociationsPageSize)}
+		if after != "" {
+			opts = append(opts, WithAfter(after))
+		}
+
+		page, err := c.ListAssociations(ctx, fromObjectType, fromObjectID, toObjectType, opts...)
+		if err != nil {
+			return err
+		}
+		if !fn(page.Results) {
+			return nil
+		}
+
+		if page.Paging == nil || page.Paging.Next == nil || page.Paging.Next.After == "" {
+			return nil
+		}
+		if page.Paging.Next.After == after {
+			return fmt.Errorf("associations paging repeated cursor %q: %w", after, ErrTooManyPages)
+		}
+		after = page.Paging.Next.After
+	}
+
+	return fmt.Errorf("associations of %s %s to %s: %w", fromObjectType, fromObjectID, toObjectType, ErrTooManyPages)
+}
+
+// findAssociation returns the association to toObjectID, or nil if the objects aren't associated
+//
+// Paging stops at the page containing toObjectID
+func (c *Client) findAssociation(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string) (*AssociatedObject, error) {
+	var found *AssociatedObject
+	err := c.walkAssociations(ctx, fromObjectType, fromObjectID, toObjectType, func(page []AssociatedObject) bool {
+		for i := range page {
+			if page[i].ToObjectID == toObjectID {
+				found = &page[i]
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// GetAssociationDetails returns the association types, with their labels, between two specific objects
+//
+// The associations of the from object are paged through until the one to toObjectID is found; use its Labels method
+// for the labels to display, e.g. "Billing contact". An *AssociationNotFoundError is returned if the objects aren't
+// associated
+func (c *Client) GetAssociationDetails(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string) (*AssociatedObject, error) {
+	found, err := c.findAssociation(ctx, fromObjectType, fromObjectID, toObjectType, toObjectID)
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, &AssociationNotFoundError{
+			FromObjectType: fromObjectType,
+			FromObjectID:   fromObjectID,
+			ToObjectType:   toObjectType,
+			ToObjectID:     toObjectID,
+		}
+	}
+	return found, nil
+}
+
+// AssociationExists reports whether two objects are associated with any association type
+//
+// HubSpot has no endpoint that reads the association between one pair of objects, so the associations of the from
+// object are paged through, 500 at a time, stopping as soon as toObjectID is found. Associations work both ways, so
+// pass the object with fewer associations of the other type as the from object, e.g. a deal rather than its company
+func (c *Client) AssociationExists(ctx context.Context, fromObjectType, fromObjectID, toObjectType, toObjectID string) (bool, error) {
+	found, err := c.findAssociation(ctx, fromObjectType, fromObjectID, toObjectType, toObjectID)
+	if err != nil {
+		return false, err
+	}
+	return found != nil, nil
+}
+
+// NewBatchAssociation builds the batch create input associating the fromObjectType object fromID with each target,
+// using the association type of the target's label
+//
+// Labels are resolved like CreateLabeledAssociation, so each type pair's labels are fetched once; an empty label
+// resolves to the unlabeled association type. A *LabelNotFoundError is returned if a label doesn't exist. To label
+// associations from several objects in one call, append the Inputs of each result before BatchCreateAssociations
+func (c *Client) NewBatchAssociation(ctx context.Context, fromObjectType, toObjectType, fromID string, to []LabeledTarget) (*BatchAssociationInput, error) {
+	input := &BatchAssociationInput{}
+	input.Inputs = make([]struct {
+		From struct {
+			ID string `json:"id"`
+		} `json:"from"`
+		To []struct {
+			ID    string            `json:"id"`
+			Types []AssociationSpec `json:"types"`
+		} `json:"to"`
+	}, 1)
+	input.Inputs[0].From.ID = fromID
+
+	for _, target := range to {
+		found, err := c.resolveLabel(ctx, fromObjectType, toObjectType, target.Label)
+		if err != nil {
+			return nil, err
+		}
+		input.Inputs[0].To = append(input.Inputs[0].To, struct {
+			ID    string            `json:"id"`
+			Types []AssociationSpec `json:"types"`
+		}{
+			ID:    target.ID,
+			Types: []AssociationSpec{{AssociationCategory: found.Category, AssociationTypeID: found.TypeID}},
+		})
+	}
+
+	return input, nil
+}
+
 // BatchCreateAssociations creates multiple associations
+//
+// A *BatchInputError is returned without sending the request if a target has no association types
 func (c *Client) BatchCreateAssociations(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v4/associations/%s/%s/batch/create",
 		fromObjectType, toObjectType))
 	req.WithContext(ctx)
@@ -90,6 +270,54 @@ func (c *Client) BatchCreateAssociations(ctx context.Context, fromObjectType, to
 	return err
 }
 
+// BatchCreateAssociationsChunked creates any number of associations in batches of at most 100 inputs
+//
+// Up to 3 batches are sent at a time; use WithBatchConcurrency to change that. A failed batch does not stop the
+// remaining ones unless ctx is done; the returned error joins the failure of every batch in input order, each naming
+// the range of inputs it covered, followed by the range left unsent when ctx is done. Nothing is sent if any input
+// fails Validate
+//
+// opts:
+// WithBatchConcurrency
+func (c *Client) BatchCreateAssociationsChunked(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput, opts ...ChunkedOption) error {
+	cfg := chunkedConfig{concurrency: defaultBatchConcurrency}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	errs := make([]error, (len(input.Inputs)+batchLimit-1)/batchLimit)
+	sem := make(chan struct{}, cfg.concurrency)
+	var wg sync.WaitGroup
+
+	for start := 0; start < len(input.Inputs); start += batchLimit {
+		end := min(start+batchLimit, len(input.Inputs))
+
+		sem <- struct{}{}
+
+		// Remaining batches would fail the same way, so report them as one unsent range
+		if err := ctx.Err(); err != nil {
+			<-sem
+			errs[start/batchLimit] = fmt.Errorf("inputs %d-%d: %w", start, len(input.Inputs)-1, err)
+			break
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+
+			chunk := &BatchAssociationInput{Inputs: input.Inputs[start:end]}
+			if err := c.BatchCreateAssociations(ctx, fromObjectType, toObjectType, chunk); err != nil {
+				errs[start/batchLimit] = fmt.Errorf("inputs %d-%d: %w", start, end-1, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
 // BatchDeleteAssociations removes multiple associations
 func (c *Client) BatchDeleteAssociations(ctx context.Context, fromObjectType, toObjectType string, input *BatchAssociationInput) error {
 	req := client.NewRequest("POST", fmt.Sprintf("/crm/v4/associations/%s/%s/batch/archive",
@@ -116,8 +344,100 @@ func (c *Client) GetAssociationLabels(ctx context.Context, fromObjectType, toObj
 
 	var labelsResp GetAssociationLabelsResponse
 	if err := json.Unmarshal(resp.Body, &labelsResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal labels response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal labels response: %w", tools.DecodeError(err, resp))
 	}
 
 	return &labelsResp, nil
 }
+
+// GetAssociationDefinitions retrieves every association type between two object types with its full metadata
+//
+// Unlike GetAssociationLabels this includes unlabeled types and reports which type is primary and which are directional
+func (c *Client) GetAssociationDefinitions(ctx context.Context, fromObjectType, toObjectType string) (*GetAssociationDefinitionsResponse, error) {
+	req := client.NewRequest("GET", fmt.Sprintf("/crm/v4/associations/definitions/%s/%s",
+		fromObjectType, toObjectType))
+	req.WithContext(ctx)
+	req.WithResourceType("associations")
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitionsResp GetAssociationDefinitionsResponse
+	if err := json.Unmarshal(resp.Body, &definitionsResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal definitions response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &definitionsResp, nil
+}
+
+// CreateAssociationLabel creates a user-defined association label between two object types
+//
+// The label's internal name is derived from label, e.g. "Billing Contact" becomes "billing_contact". Pass a non-empty
+// inverse to create a paired label, where inverse is shown when viewing the association from toObjectType
+func (c *Client) CreateAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, label string, inverse string) (*CreateAssociationLabelResponse, error) {
+	req := client.NewRequest("POST", fmt.Sprintf("/crm/v4/associations/%s/%s/labels",
+		fromObjectType, toObjectType))
+	req.WithContext(ctx)
+	req.WithResourceType("associations")
+	req.WithBody(&CreateAssociationLabelInput{
+		Label:        label,
+		Name:         labelName(label),
+		InverseLabel: inverse,
+	})
+
+	resp, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.labels.invalidate(ctx, fromObjectType, toObjectType)
+	if inverse != "" {
+		c.labels.invalidate(ctx, toObjectType, fromObjectType)
+	}
+
+	var labelResp CreateAssociationLabelResponse
+	if err := json.Unmarshal(resp.Body, &labelResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal label response: %w", tools.DecodeError(err, resp))
+	}
+
+	return &labelResp, nil
+}
+
+// DeleteAssociationLabel deletes a user-defined association label by its association type ID
+func (c *Client) DeleteAssociationLabel(ctx context.Context, fromObjectType, toObjectType string, typeID int) error {
+	req := client.NewRequest("DELETE", fmt.Sprintf("/crm/v4/associations/%s/%s/labels/%d",
+		fromObjectType, toObjectType, typeID))
+	req.WithContext(ctx)
+	req.WithResourceType("associations")
+
+	_, err := c.apiClient.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// A paired label is deleted in both directions
+	c.labels.invalidate(ctx, fromObjectType, toObjectType)
+	c.labels.invalidate(ctx, toObjectType, fromObjectType)
+
+	return nil
+}
+
+// labelName converts a display label to the lowercase, underscore separated internal name HubSpot expects
+func labelName(label string) string {
+	var b strings.Builder
+	pendingSeparator := false
+	for _, r := range strings.ToLower(label) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingSeparator && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			pendingSeparator = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingSeparator = true
+	}
+	return b.String()
+}
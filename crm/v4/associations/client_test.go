@@ -2,11 +2,17 @@ package associations
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/josiah-hester/go-hubspot-sdk/client"
+	"github.com/josiah-hester/go-hubspot-sdk/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -289,6 +295,39 @@ func TestListAssociations_WithOptions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestListAssociations_PagingCursors tests that each page's cursor is sent back as after, and that before is sent
+func TestListAssociations_PagingCursors(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("before") == "page2":
+			assert.False(t, query.Has("after"))
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "1"}], "paging": {"next": {"after": "page2"}}}`)
+		case query.Get("after") == "page2":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "2"}], "paging": {"prev": {"before": "page2"}}}`)
+		default:
+			assert.False(t, query.Has("after"))
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "1"}], "paging": {"next": {"after": "page2"}}}`)
+		}
+	})
+	defer server.Close()
+
+	first, err := assocClient.ListAssociations(context.Background(), "contacts", "123", "companies", WithAfter(""))
+	require.NoError(t, err)
+	assert.Equal(t, "1", first.Results[0].ToObjectID)
+
+	second, err := assocClient.ListAssociations(context.Background(), "contacts", "123", "companies",
+		WithAfter(first.Paging.Next.After))
+	require.NoError(t, err)
+	assert.Equal(t, "2", second.Results[0].ToObjectID)
+	assert.Equal(t, "page2", second.Paging.Prev.Before)
+
+	back, err := assocClient.ListAssociations(context.Background(), "contacts", "123", "companies",
+		WithBefore(second.Paging.Prev.Before))
+	require.NoError(t, err)
+	assert.Equal(t, "1", back.Results[0].ToObjectID)
+}
+
 func TestListAssociations_Empty(t *testing.T) {
 	responseJSON := `{"results": [], "paging": null}`
 
@@ -305,6 +344,134 @@ func TestListAssociations_Empty(t *testing.T) {
 	assert.Len(t, resp.Results, 0)
 }
 
+// TestListAllAssociations_Success tests walking every page of associations
+func TestListAllAssociations_Success(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "500", r.URL.Query().Get("limit"))
+		switch r.URL.Query().Get("after") {
+		case "":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "1"}, {"toObjectId": "2"}], "paging": {"next": {"after": "2"}}}`)
+		case "2":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "3"}], "paging": {"next": null}}`)
+		default:
+			t.Errorf("unexpected after %q", r.URL.Query().Get("after"))
+		}
+	})
+	defer server.Close()
+
+	results, err := assocClient.ListAllAssociations(context.Background(), "contacts", "123", "companies")
+
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "3", results[2].ToObjectID)
+}
+
+// TestListAllAssociations_RepeatedCursor tests that a server repeating its cursor doesn't loop forever
+func TestListAllAssociations_RepeatedCursor(t *testing.T) {
+	requests := 0
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": "1"}], "paging": {"next": {"after": "same"}}}`)
+	})
+	defer server.Close()
+
+	results, err := assocClient.ListAllAssociations(context.Background(), "contacts", "123", "companies")
+
+	require.ErrorIs(t, err, ErrTooManyPages)
+	assert.ErrorIs(t, err, client.ErrTooManyPages)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, requests)
+}
+
+// TestGetAssociationDetails_Success tests reading the labels between two objects
+func TestGetAssociationDetails_Success(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/crm/v4/objects/contacts/123/associations/companies", r.URL.Path)
+		respondJSON(w, http.StatusOK, `{
+			"results": [
+				{"toObjectId": 455, "associationTypes": [{"category": "HUBSPOT_DEFINED", "typeId": 279, "label": null}]},
+				{"toObjectId": 456, "associationTypes": [
+					{"category": "HUBSPOT_DEFINED", "typeId": 1, "label": "Primary"},
+					{"category": "USER_DEFINED", "typeId": 28, "label": "Billing contact"},
+					{"category": "HUBSPOT_DEFINED", "typeId": 279, "label": null}
+				]}
+			]
+		}`)
+	})
+	defer server.Close()
+
+	details, err := assocClient.GetAssociationDetails(context.Background(), "contacts", "123", "companies", "456")
+
+	require.NoError(t, err)
+	assert.Equal(t, "456", details.ToObjectID)
+	require.Len(t, details.AssociationTypes, 3)
+	assert.Equal(t, AssociationSpec{AssociationCategory: "USER_DEFINED", AssociationTypeID: 28, Label: "Billing contact"}, details.AssociationTypes[1])
+	assert.Equal(t, []string{"Primary", "Billing contact"}, details.Labels())
+}
+
+// TestGetAssociationDetails_NotAssociated tests the error returned for objects that aren't associated
+func TestGetAssociationDetails_NotAssociated(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": 455, "associationTypes": []}]}`)
+	})
+	defer server.Close()
+
+	_, err := assocClient.GetAssociationDetails(context.Background(), "contacts", "123", "companies", "456")
+
+	var notFoundErr *AssociationNotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "contacts 123 is not associated with companies 456", err.Error())
+}
+
+// TestAssociationExists tests checking for an association, stopping at the page that contains it
+func TestAssociationExists(t *testing.T) {
+	requests := 0
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Query().Get("after") {
+		case "":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": 1}, {"toObjectId": 2}], "paging": {"next": {"after": "2"}}}`)
+		case "2":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": 3}], "paging": {"next": {"after": "3"}}}`)
+		case "3":
+			respondJSON(w, http.StatusOK, `{"results": [{"toObjectId": 4}]}`)
+		}
+	})
+	defer server.Close()
+
+	exists, err := assocClient.AssociationExists(context.Background(), "deals", "10", "companies", "3")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, 2, requests)
+
+	requests = 0
+	exists, err = assocClient.AssociationExists(context.Background(), "deals", "10", "companies", "5")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Equal(t, 3, requests)
+}
+
+// TestAssociationExists_Error tests that request failures are returned rather than reported as no association
+func TestAssociationExists_Error(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "Unknown object type"}`)
+	})
+	defer server.Close()
+
+	exists, err := assocClient.AssociationExists(context.Background(), "deals", "10", "widgets", "3")
+
+	require.Error(t, err)
+	assert.False(t, exists)
+}
+
+// TestAssociationSpec_MarshalJSON tests that labels read from HubSpot aren't sent when writing associations
+func TestAssociationSpec_MarshalJSON(t *testing.T) {
+	body, err := json.Marshal(AssociationSpec{AssociationCategory: "USER_DEFINED", AssociationTypeID: 28, Label: "Billing contact"})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"associationCategory": "USER_DEFINED", "associationTypeId": 28}`, string(body))
+}
+
 // TestBatchCreateAssociations tests batch create
 func TestBatchCreateAssociations_Success(t *testing.T) {
 	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -354,6 +521,200 @@ func TestBatchCreateAssociations_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestBatchCreateAssociationsChunked tests that large inputs are split into batches of 100
+// TestNewBatchAssociation tests building a batch create input from association labels
+func TestNewBatchAssociation(t *testing.T) {
+	labelRequests := 0
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crm/v4/associations/contacts/companies/labels":
+			labelRequests++
+			respondJSON(w, http.StatusOK, `{"results": [
+				{"category": "HUBSPOT_DEFINED", "typeId": 279, "label": null},
+				{"category": "USER_DEFINED", "typeId": 42, "label": "Decision Maker"}
+			]}`)
+		case "/crm/v4/associations/contacts/companies/batch/create":
+			var body BatchAssociationInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Len(t, body.Inputs, 1)
+			assert.Equal(t, "1", body.Inputs[0].From.ID)
+			require.Len(t, body.Inputs[0].To, 2)
+			assert.Equal(t, "10", body.Inputs[0].To[0].ID)
+			assert.Equal(t, []AssociationSpec{{AssociationCategory: "USER_DEFINED", AssociationTypeID: 42}}, body.Inputs[0].To[0].Types)
+			assert.Equal(t, "11", body.Inputs[0].To[1].ID)
+			assert.Equal(t, []AssociationSpec{{AssociationCategory: "HUBSPOT_DEFINED", AssociationTypeID: 279}}, body.Inputs[0].To[1].Types)
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE"}`)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	input, err := assocClient.NewBatchAssociation(context.Background(), "contacts", "companies", "1", []LabeledTarget{
+		{ID: "10", Label: "Decision Maker"},
+		{ID: "11"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, labelRequests)
+
+	require.NoError(t, assocClient.BatchCreateAssociations(context.Background(), "contacts", "companies", input))
+
+	_, err = assocClient.NewBatchAssociation(context.Background(), "contacts", "companies", "1", []LabeledTarget{
+		{ID: "10", Label: "Champion"},
+	})
+	var labelErr *LabelNotFoundError
+	require.ErrorAs(t, err, &labelErr)
+	assert.Equal(t, "Champion", labelErr.Label)
+}
+
+// TestBatchCreateAssociations_EmptyTypes tests that a target without association types is rejected before sending
+func TestBatchCreateAssociations_EmptyTypes(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	input := &BatchAssociationInput{}
+	input.Inputs = slices.Grow(input.Inputs, 2)[:2]
+	input.Inputs[0].From.ID = "1"
+	input.Inputs[1].From.ID = "2"
+	input.Inputs[1].To = append(input.Inputs[1].To, struct {
+		ID    string            `json:"id"`
+		Types []AssociationSpec `json:"types"`
+	}{ID: "20"})
+
+	err := assocClient.BatchCreateAssociations(context.Background(), "contacts", "companies", input)
+	var inputErr *BatchInputError
+	require.ErrorAs(t, err, &inputErr)
+	assert.Equal(t, 1, inputErr.Input)
+	assert.Equal(t, "20", inputErr.ToObjectID)
+
+	err = assocClient.BatchCreateAssociationsChunked(context.Background(), "contacts", "companies", input)
+	require.ErrorAs(t, err, &inputErr)
+}
+
+func TestBatchCreateAssociationsChunked(t *testing.T) {
+	newInput := func(n int) *BatchAssociationInput {
+		input := &BatchAssociationInput{}
+		input.Inputs = slices.Grow(input.Inputs, n)[:n]
+		for i := range input.Inputs {
+			input.Inputs[i].From.ID = strconv.Itoa(i)
+		}
+		return input
+	}
+
+	t.Run("Splits into batches", func(t *testing.T) {
+		var sizes []int
+		var firstIDs []string
+		server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/crm/v4/associations/line_items/deals/batch/create", r.URL.Path)
+
+			var body BatchAssociationInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			sizes = append(sizes, len(body.Inputs))
+			firstIDs = append(firstIDs, body.Inputs[0].From.ID)
+
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE"}`)
+		})
+		defer server.Close()
+
+		err := assocClient.BatchCreateAssociationsChunked(context.Background(), "line_items", "deals", newInput(250), WithBatchConcurrency(1))
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{100, 100, 50}, sizes)
+		assert.Equal(t, []string{"0", "100", "200"}, firstIDs)
+	})
+
+	t.Run("Aggregates batch errors", func(t *testing.T) {
+		requests := 0
+		server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 2 {
+				respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "invalid association", "category": "VALIDATION_ERROR"}`)
+				return
+			}
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE"}`)
+		})
+		defer server.Close()
+
+		err := assocClient.BatchCreateAssociationsChunked(context.Background(), "line_items", "deals", newInput(250), WithBatchConcurrency(1))
+
+		require.Error(t, err)
+		assert.Equal(t, 3, requests, "later batches are still sent")
+		assert.Contains(t, err.Error(), "inputs 100-199")
+
+		var hubspotErr *client.HubSpotError
+		assert.ErrorAs(t, err, &hubspotErr)
+	})
+
+	t.Run("Concurrent batches", func(t *testing.T) {
+		var mu sync.Mutex
+		inFlight, peak, requests := 0, 0, 0
+		server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var body BatchAssociationInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			mu.Lock()
+			inFlight++
+			requests++
+			peak = max(peak, inFlight)
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			if id := body.Inputs[0].From.ID; id == "300" || id == "700" {
+				respondJSON(w, http.StatusBadRequest, `{"status": "error", "message": "invalid association", "category": "VALIDATION_ERROR"}`)
+				return
+			}
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE"}`)
+		})
+		defer server.Close()
+
+		err := assocClient.BatchCreateAssociationsChunked(context.Background(), "line_items", "deals", newInput(1000), WithBatchConcurrency(4))
+
+		require.Error(t, err)
+		assert.Regexp(t, `(?s)^inputs 300-399: .*\ninputs 700-799: `, err.Error(), "errors are in input order")
+		assert.Equal(t, 10, requests)
+		assert.LessOrEqual(t, peak, 4)
+		assert.Greater(t, peak, 1)
+	})
+
+	t.Run("Cancelled partway", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		requests := 0
+		server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			cancel()
+			respondJSON(w, http.StatusOK, `{"status": "COMPLETE"}`)
+		})
+		defer server.Close()
+
+		err := assocClient.BatchCreateAssociationsChunked(ctx, "line_items", "deals", newInput(250), WithBatchConcurrency(1))
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Contains(t, err.Error(), "inputs 100-249", "unsent inputs are reported")
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("no requests expected")
+		})
+		defer server.Close()
+
+		err := assocClient.BatchCreateAssociationsChunked(context.Background(), "line_items", "deals", &BatchAssociationInput{})
+
+		assert.NoError(t, err)
+	})
+}
+
 // TestBatchDeleteAssociations tests batch delete
 func TestBatchDeleteAssociations_Success(t *testing.T) {
 	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -420,6 +781,106 @@ func TestGetAssociationLabels_Empty(t *testing.T) {
 	assert.Len(t, resp.Results, 0)
 }
 
+// TestGetAssociationDefinitions_Success tests unmarshaling a definitions response
+func TestGetAssociationDefinitions_Success(t *testing.T) {
+	responseJSON := `{
+		"results": [
+			{"category": "HUBSPOT_DEFINED", "typeId": 279, "label": "", "primary": true, "directional": true},
+			{"category": "HUBSPOT_DEFINED", "typeId": 1, "label": "Primary", "primary": false, "directional": true},
+			{"category": "USER_DEFINED", "typeId": 36, "label": "Billing Contact", "primary": false, "directional": false}
+		]
+	}`
+
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/crm/v4/associations/definitions/contacts/companies", r.URL.Path)
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	resp, err := assocClient.GetAssociationDefinitions(context.Background(),
+		"contacts", "companies")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.Equal(t, AssociationDefinition{
+		Category:    AssociationCategoryHubSpotDefined,
+		TypeID:      279,
+		Primary:     true,
+		Directional: true,
+	}, resp.Results[0])
+	assert.Equal(t, "Primary", resp.Results[1].Label)
+	assert.Equal(t, AssociationCategoryUserDefined, resp.Results[2].Category)
+	assert.Equal(t, 36, resp.Results[2].TypeID)
+	assert.False(t, resp.Results[2].Directional)
+}
+
+// TestCreateAssociationLabel_Success tests creating a paired user-defined label
+func TestCreateAssociationLabel_Success(t *testing.T) {
+	responseJSON := `{
+		"results": [
+			{"category": "USER_DEFINED", "typeId": 36, "label": "Billing Contact"},
+			{"category": "USER_DEFINED", "typeId": 37, "label": "Billed Company"}
+		]
+	}`
+
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/crm/v4/associations/contacts/companies/labels", r.URL.Path)
+
+		var body CreateAssociationLabelInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Billing Contact", body.Label)
+		assert.Equal(t, "billing_contact", body.Name)
+		assert.Equal(t, "Billed Company", body.InverseLabel)
+
+		respondJSON(w, http.StatusOK, responseJSON)
+	})
+	defer server.Close()
+
+	resp, err := assocClient.CreateAssociationLabel(context.Background(),
+		"contacts", "companies", "Billing Contact", "Billed Company")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	assert.Equal(t, 36, resp.Results[0].TypeID)
+	assert.Equal(t, AssociationCategoryUserDefined, resp.Results[0].Category)
+}
+
+// TestCreateAssociationLabel_NoInverse tests that an empty inverse label is omitted
+func TestCreateAssociationLabel_NoInverse(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.NotContains(t, body, "inverseLabel")
+		assert.Equal(t, "decision_maker_2", body["name"])
+
+		respondJSON(w, http.StatusOK, `{"results": [{"category": "USER_DEFINED", "typeId": 38, "label": "Decision-Maker (2)"}]}`)
+	})
+	defer server.Close()
+
+	resp, err := assocClient.CreateAssociationLabel(context.Background(),
+		"contacts", "deals", "Decision-Maker (2)", "")
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Results, 1)
+}
+
+// TestDeleteAssociationLabel_Success tests deleting a label by type ID
+func TestDeleteAssociationLabel_Success(t *testing.T) {
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/crm/v4/associations/contacts/companies/labels/36", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	err := assocClient.DeleteAssociationLabel(context.Background(), "contacts", "companies", 36)
+
+	assert.NoError(t, err)
+}
+
 // TestAssociations_MultipleObjectTypes tests various object type combinations
 func TestAssociations_MultipleObjectTypes(t *testing.T) {
 	testCases := []struct {
@@ -464,3 +925,65 @@ func TestAssociations_MultipleObjectTypes(t *testing.T) {
 		})
 	}
 }
+
+// TestCreateLabeledAssociation tests that labels are resolved to type IDs and cached per object type pair
+func TestCreateLabeledAssociation(t *testing.T) {
+	labelRequests := 0
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/crm/v4/associations/contacts/companies/labels":
+			labelRequests++
+			respondJSON(w, http.StatusOK, `{"results": [
+				{"category": "HUBSPOT_DEFINED", "typeId": 279, "label": null},
+				{"category": "USER_DEFINED", "typeId": 42, "label": "Decision Maker"}
+			]}`)
+		case r.Method == "PUT":
+			var specs []AssociationSpec
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&specs))
+			assert.Equal(t, []AssociationSpec{{AssociationCategory: "USER_DEFINED", AssociationTypeID: 42}}, specs)
+			respondJSON(w, http.StatusOK, `{"fromObjectTypeId": "0-1", "fromObjectId": 1, "toObjectTypeId": "0-2", "toObjectId": 2, "labels": ["Decision Maker"]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	for _, toID := range []string{"2", "3"} {
+		_, err := assocClient.CreateLabeledAssociation(context.Background(), "contacts", "1", "companies", toID, "Decision Maker")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, labelRequests, "labels are cached")
+
+	_, err := assocClient.CreateLabeledAssociation(context.Background(), "contacts", "1", "companies", "2", "Champion")
+	var notFound *LabelNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "Champion", notFound.Label)
+	assert.Equal(t, 2, labelRequests, "an unknown label refetches the labels once")
+}
+
+// TestCreateLabeledAssociation_PerTenant tests that labels cached for one account aren't used for another
+func TestCreateLabeledAssociation_PerTenant(t *testing.T) {
+	var labelTokens []string
+	server, assocClient := setupMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			labelTokens = append(labelTokens, r.Header.Get("Authorization"))
+			respondJSON(w, http.StatusOK, `{"results": [{"category": "USER_DEFINED", "typeId": 42, "label": "Decision Maker"}]}`)
+		case "PUT":
+			respondJSON(w, http.StatusOK, `{"fromObjectTypeId": "0-1", "fromObjectId": 1, "toObjectTypeId": "0-2", "toObjectId": 2}`)
+		}
+	})
+	defer server.Close()
+
+	for _, tenant := range []string{"tenant-a", "tenant-b", "tenant-a"} {
+		ctx := client.ContextWithAccessToken(context.Background(), tenant)
+		_, err := assocClient.CreateLabeledAssociation(ctx, "contacts", "1", "companies", "2", "Decision Maker")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"Bearer tenant-a", "Bearer tenant-b"}, labelTokens)
+}
+
+// TestAPI_CoversClient tests that API lists every exported Client method
+func TestAPI_CoversClient(t *testing.T) {
+	apitest.CoversClient[API, *Client](t)
+}
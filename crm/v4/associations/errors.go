@@ -0,0 +1,47 @@
+package associations
+
+import (
+	"fmt"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// ErrTooManyPages is returned by ListAllAssociations when paging doesn't end within MaxListAssociationsPages pages
+//
+// It wraps client.ErrTooManyPages, so either can be checked with errors.Is
+var ErrTooManyPages = fmt.Errorf("%w of associations", client.ErrTooManyPages)
+
+// LabelNotFoundError is returned when no association label with the given name exists between two object types
+type LabelNotFoundError struct {
+	FromObjectType string
+	ToObjectType   string
+	Label          string
+}
+
+func (e *LabelNotFoundError) Error() string {
+	return fmt.Sprintf("association label %q not found from %s to %s", e.Label, e.FromObjectType, e.ToObjectType)
+}
+
+// AssociationNotFoundError is returned by GetAssociationDetails when two objects aren't associated
+type AssociationNotFoundError struct {
+	FromObjectType string
+	FromObjectID   string
+	ToObjectType   string
+	ToObjectID     string
+}
+
+func (e *AssociationNotFoundError) Error() string {
+	return fmt.Sprintf("%s %s is not associated with %s %s", e.FromObjectType, e.FromObjectID, e.ToObjectType, e.ToObjectID)
+}
+
+// BatchInputError is returned before a batch request is sent when one of its inputs is invalid
+type BatchInputError struct {
+	Input        int // Index of the invalid input
+	FromObjectID string
+	ToObjectID   string
+	Message      string
+}
+
+func (e *BatchInputError) Error() string {
+	return fmt.Sprintf("batch input %d (%s to %s): %s", e.Input, e.FromObjectID, e.ToObjectID, e.Message)
+}
@@ -0,0 +1,72 @@
+package associations
+
+import (
+	"context"
+	"sync"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// labelCache remembers the association labels of each object type pair so labels can be resolved to type IDs
+// without a request per association
+type labelCache struct {
+	mu     sync.Mutex
+	byPair map[string][]AssociationLabel
+}
+
+// labelPairKey returns the labelCache key for associations from fromObjectType to toObjectType
+//
+// Labels are custom per account, so the key includes the client.TenantKey of ctx
+func labelPairKey(ctx context.Context, fromObjectType, toObjectType string) string {
+	return client.TenantKey(ctx) + ":" + fromObjectType + "/" + toObjectType
+}
+
+// invalidate drops the cached labels of a type pair for the account of ctx, e.g. after one is created or deleted
+func (lc *labelCache) invalidate(ctx context.Context, fromObjectType, toObjectType string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	delete(lc.byPair, labelPairKey(ctx, fromObjectType, toObjectType))
+}
+
+// resolveLabel returns the association label named label between the two object types
+//
+// Labels are fetched on first use and refetched once when label is not among the cached ones, so labels created
+// outside this client are picked up without a request on every cache hit
+func (c *Client) resolveLabel(ctx context.Context, fromObjectType, toObjectType, label string) (*AssociationLabel, error) {
+	key := labelPairKey(ctx, fromObjectType, toObjectType)
+
+	c.labels.mu.Lock()
+	cached, ok := c.labels.byPair[key]
+	c.labels.mu.Unlock()
+
+	if ok {
+		if found := findLabel(cached, label); found != nil {
+			return found, nil
+		}
+	}
+
+	labelsResp, err := c.GetAssociationLabels(ctx, fromObjectType, toObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.labels.mu.Lock()
+	c.labels.byPair[key] = labelsResp.Results
+	c.labels.mu.Unlock()
+
+	if found := findLabel(labelsResp.Results, label); found != nil {
+		return found, nil
+	}
+	return nil, &LabelNotFoundError{FromObjectType: fromObjectType, ToObjectType: toObjectType, Label: label}
+}
+
+// findLabel returns the entry of labels whose label is exactly label, or nil
+func findLabel(labels []AssociationLabel, label string) *AssociationLabel {
+	for i := range labels {
+		if labels[i].Label == label {
+			return &labels[i]
+		}
+	}
+	return nil
+}
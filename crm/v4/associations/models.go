@@ -1,5 +1,10 @@
 package associations
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // Association category constants
 const (
 	// AssociationCategoryHubSpotDefined represents HubSpot's predefined associations
@@ -16,6 +21,38 @@ const (
 type AssociationSpec struct {
 	AssociationCategory string `json:"associationCategory"`
 	AssociationTypeID   int    `json:"associationTypeId"`
+
+	// Label is the association's label, e.g. "Billing contact", when read from HubSpot. It is empty for unlabeled
+	// associations and is never sent when writing associations
+	Label string `json:"-"`
+}
+
+// UnmarshalJSON accepts both the associationCategory and associationTypeId keys used when writing associations and
+// the category, typeId and label keys HubSpot returns when listing them
+func (s *AssociationSpec) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		AssociationCategory string `json:"associationCategory"`
+		AssociationTypeID   int    `json:"associationTypeId"`
+		Category            string `json:"category"`
+		TypeID              int    `json:"typeId"`
+		Label               string `json:"label"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*s = AssociationSpec{
+		AssociationCategory: raw.AssociationCategory,
+		AssociationTypeID:   raw.AssociationTypeID,
+		Label:               raw.Label,
+	}
+	if s.AssociationCategory == "" {
+		s.AssociationCategory = raw.Category
+	}
+	if s.AssociationTypeID == 0 {
+		s.AssociationTypeID = raw.TypeID
+	}
+	return nil
 }
 
 // AssociationLabel represents an association label/type
@@ -55,6 +92,27 @@ type BatchAssociationInput struct {
 	} `json:"inputs"`
 }
 
+// Validate checks that every target of every input has at least one association type
+//
+// HubSpot rejects the whole batch when any target has an empty types array. A *BatchInputError is returned for the
+// first target without types
+func (in *BatchAssociationInput) Validate() error {
+	for i, input := range in.Inputs {
+		for _, to := range input.To {
+			if len(to.Types) == 0 {
+				return &BatchInputError{Input: i, FromObjectID: input.From.ID, ToObjectID: to.ID, Message: "no association types"}
+			}
+		}
+	}
+	return nil
+}
+
+// LabeledTarget is an object to associate with, and the label of the association, for NewBatchAssociation
+type LabeledTarget struct {
+	ID    string
+	Label string // Empty for the unlabeled association type
+}
+
 // AssociationResponse represents response from creating/updating association operations
 type AssociationResponse struct {
 	FromObjectTypeID string   `json:"fromObjectTypeId"`
@@ -76,6 +134,36 @@ type AssociatedObject struct {
 	AssociationTypes []AssociationSpec `json:"associationTypes"`
 }
 
+// UnmarshalJSON accepts toObjectId as either a JSON number, as HubSpot sends it, or a string
+func (o *AssociatedObject) UnmarshalJSON(data []byte) error {
+	type plain AssociatedObject
+	var raw struct {
+		plain
+		ToObjectID json.RawMessage `json:"toObjectId"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*o = AssociatedObject(raw.plain)
+	o.ToObjectID = strings.Trim(string(raw.ToObjectID), `"`)
+	if o.ToObjectID == "null" {
+		o.ToObjectID = ""
+	}
+	return nil
+}
+
+// Labels returns the labels of the association types that have one, in the order HubSpot returned them
+func (o *AssociatedObject) Labels() []string {
+	var labels []string
+	for _, spec := range o.AssociationTypes {
+		if spec.Label != "" {
+			labels = append(labels, spec.Label)
+		}
+	}
+	return labels
+}
+
 // Paging represents pagination information
 type Paging struct {
 	Next *PagingLink `json:"next"`
@@ -84,8 +172,9 @@ type Paging struct {
 
 // PagingLink represents a pagination link
 type PagingLink struct {
-	After string `json:"after"`
-	Link  string `json:"link"`
+	After  string `json:"after"`
+	Before string `json:"before"`
+	Link   string `json:"link"`
 }
 
 // BatchAssociationResponse represents response from batch operations
@@ -100,3 +189,31 @@ type BatchAssociationResponse struct {
 type GetAssociationLabelsResponse struct {
 	Results []AssociationLabel `json:"results"`
 }
+
+// AssociationDefinition represents an association type available between two object types
+type AssociationDefinition struct {
+	Category    string `json:"category"`
+	TypeID      int    `json:"typeId"`
+	Label       string `json:"label"`
+	Primary     bool   `json:"primary"`     // The default unlabeled type HubSpot uses for the pair
+	Directional bool   `json:"directional"` // The type has a different inverse type in the other direction
+}
+
+// GetAssociationDefinitionsResponse represents response from getting association definitions
+type GetAssociationDefinitionsResponse struct {
+	Results []AssociationDefinition `json:"results"`
+}
+
+// CreateAssociationLabelInput represents input for creating a user-defined association label
+type CreateAssociationLabelInput struct {
+	Label        string `json:"label"`
+	Name         string `json:"name"`
+	InverseLabel string `json:"inverseLabel,omitempty"`
+}
+
+// CreateAssociationLabelResponse represents response from creating an association label
+//
+// Paired labels return two results, one for each direction
+type CreateAssociationLabelResponse struct {
+	Results []AssociationLabel `json:"results"`
+}
@@ -16,9 +16,47 @@ func WithLimit(limit int) AssociationOption {
 	}
 }
 
-// WithAfter sets the paging cursor
+// WithAfter requests the page after the cursor in a previous response's Paging.Next.After
+//
+// The v4 list endpoint reads the cursor from the after query parameter. An empty cursor is not sent, so it requests
+// the first page
 func WithAfter(after string) AssociationOption {
 	return func(req *client.Request) {
-		req.AddQueryParam("after", after)
+		if after != "" {
+			req.AddQueryParam("after", after)
+		}
+	}
+}
+
+// WithBefore requests the page before the cursor in a previous response's Paging.Prev.Before
+//
+// HubSpot only includes paging.prev on some responses; when it is absent, page backwards by keeping the After
+// cursors already seen. An empty cursor is not sent
+func WithBefore(before string) AssociationOption {
+	return func(req *client.Request) {
+		if before != "" {
+			req.AddQueryParam("before", before)
+		}
+	}
+}
+
+// defaultBatchConcurrency is the number of chunks sent at once when WithBatchConcurrency is not used
+const defaultBatchConcurrency = 3
+
+// ChunkedOption configures how chunked batch methods send their chunks
+type ChunkedOption func(*chunkedConfig)
+
+// chunkedConfig holds the settings of a chunked batch call
+type chunkedConfig struct {
+	concurrency int
+}
+
+// WithBatchConcurrency sends up to n chunks in parallel; values below 1 send them one at a time
+//
+// Every chunk still goes through the client's rate limiter, but HubSpot also caps the number of concurrent requests
+// per app, so higher values trade a faster run for a greater chance of 429 responses and retries. The default is 3
+func WithBatchConcurrency(n int) ChunkedOption {
+	return func(cfg *chunkedConfig) {
+		cfg.concurrency = max(n, 1)
 	}
 }
@@ -0,0 +1,24 @@
+// Package apitest provides test helpers shared by the service packages
+package apitest
+
+import (
+	"reflect"
+	"testing"
+)
+
+// CoversClient fails t if any exported method of Client is missing from the interface API
+//
+// Each service package asserts at compile time that its *Client implements API; this checks the other direction, so a
+// new Client method can't be left out of the interface
+func CoversClient[API, Client any](t *testing.T) {
+	t.Helper()
+
+	api := reflect.TypeFor[API]()
+	clientType := reflect.TypeFor[Client]()
+	for i := range clientType.NumMethod() {
+		name := clientType.Method(i).Name
+		if _, ok := api.MethodByName(name); !ok {
+			t.Errorf("%s is missing %s", api, name)
+		}
+	}
+}
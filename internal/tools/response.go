@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
+)
+
+// maxErrorBodyBytes caps how much of a response body is included in a DecodeError
+const maxErrorBodyBytes = 512
+
+// ResponseDecodeError describes a response body that could not be decoded
+//
+// Body holds at most the first 512 bytes of the response so that HTML error pages don't flood logs
+type ResponseDecodeError struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+	Err         error
+}
+
+func (e *ResponseDecodeError) Error() string {
+	return fmt.Sprintf("%v (status: %d, content-type: %q, body: %q)", e.Err, e.StatusCode, e.ContentType, e.Body)
+}
+
+func (e *ResponseDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError wraps err with the status, content type and a truncated copy of the body of resp
+func DecodeError(err error, resp *client.Response) error {
+	return &ResponseDecodeError{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Headers.Get("Content-Type"),
+		Body:        truncateBody(resp.Body, maxErrorBodyBytes),
+		Err:         err,
+	}
+}
+
+// truncateBody returns body as a string cut to at most limit bytes on a rune boundary, followed by an ellipsis if cut
+func truncateBody(body []byte, limit int) string {
+	if len(body) <= limit {
+		return string(body)
+	}
+
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	return string(body[:cut]) + "..."
+}
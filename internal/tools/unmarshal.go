@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+
+	"github.com/josiah-hester/go-hubspot-sdk/client"
 )
 
 type RequiredTag struct {
@@ -17,47 +19,58 @@ func NewRequiredTagStruct(strct any) *RequiredTag {
 	}
 }
 
-func (r *RequiredTag) UnmarhsalJSON(data []byte) error {
+// UnmarshalJSON unmarshals data into the wrapped struct and checks its required fields
+func (r *RequiredTag) UnmarshalJSON(data []byte) error {
 	// Unmarshal into the target struct first
 	if err := json.Unmarshal(data, r.Struct); err != nil {
 		return err
 	}
 
 	// Perform validation based on "required" tag
-	val := reflect.ValueOf(r.Struct).Elem() // Get the underlying struct value
-	typ := val.Type()
+	return ValidateRequired(r.Struct)
+}
 
-	for i := 0; i < val.NumField(); i++ {
-		field := typ.Field(i)
-		fieldValue := val.Field(i)
-
-		_, ok := field.Tag.Lookup("required")
-		if ok {
-			// Check if the field is a zero value (e.g., empty string, zero int, nil pointer)
-			if reflect.DeepEqual(fieldValue.Interface(), reflect.Zero(field.Type).Interface()) {
-				return fmt.Errorf("field '%s' is required but is empty", field.Name)
-			}
-		}
-	}
-	return nil
+// UnmarhsalJSON is a misspelled alias of UnmarshalJSON
+//
+// Deprecated: use UnmarshalJSON, which also satisfies json.Unmarshaler.
+func (r *RequiredTag) UnmarhsalJSON(data []byte) error {
+	return r.UnmarshalJSON(data)
 }
 
 func (r *RequiredTag) MarshalJSON() ([]byte, error) {
 	// Perform validation based on "required" tag
-	val := reflect.ValueOf(r.Struct).Elem()
-	typ := val.Type()
+	if err := ValidateRequired(r.Struct); err != nil {
+		return nil, err
+	}
+	// Marshal the source struct if validation passes
+	return json.Marshal(r.Struct)
+}
 
+// ValidateRequired checks that every top-level field of input tagged `required:"yes"` is set, returning a
+// *client.RequiredFieldError for the first one that is not
+//
+// input must be a struct or a pointer to one. A field counts as unset when it holds its zero value, so an empty but
+// non-nil slice or map passes. Nested structs are not checked, since their tags describe HubSpot responses as often
+// as requests
+func ValidateRequired(input any) error {
+	val := reflect.ValueOf(input)
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return fmt.Errorf("input is required but is nil")
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot validate required fields of %s", val.Kind())
+	}
+
+	typ := val.Type()
 	for i := 0; i < val.NumField(); i++ {
 		field := typ.Field(i)
-		fieldValue := val.Field(i)
 
-		_, ok := field.Tag.Lookup("required")
-		if ok {
-			if reflect.DeepEqual(fieldValue.Interface(), reflect.Zero(field.Type).Interface()) {
-				return nil, fmt.Errorf("field '%s' is required but is empty", field.Name)
-			}
+		if _, ok := field.Tag.Lookup("required"); ok && val.Field(i).IsZero() {
+			return &client.RequiredFieldError{Field: field.Name}
 		}
 	}
-	// Marshal the source struct if validation passes
-	return json.Marshal(r.Struct)
+	return nil
 }
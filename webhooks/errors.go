@@ -0,0 +1,14 @@
+package webhooks
+
+import "errors"
+
+var (
+	// ErrMissingSignature is returned when a request has no v3 signature or timestamp
+	ErrMissingSignature = errors.New("webhook request has no signature")
+
+	// ErrInvalidSignature is returned when a request's signature doesn't match the one computed with the app secret
+	ErrInvalidSignature = errors.New("webhook signature is invalid")
+
+	// ErrExpiredTimestamp is returned when a request's timestamp is more than MaxTimestampAge from now or can't be parsed
+	ErrExpiredTimestamp = errors.New("webhook timestamp is expired")
+)
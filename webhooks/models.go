@@ -0,0 +1,120 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Event kinds, the part of a subscriptionType after the object type, e.g. propertyChange in contact.propertyChange
+const (
+	KindCreation          = "creation"
+	KindDeletion          = "deletion"
+	KindRestore           = "restore"
+	KindPrivacyDeletion   = "privacyDeletion"
+	KindPropertyChange    = "propertyChange"
+	KindAssociationChange = "associationChange"
+	KindMerge             = "merge"
+)
+
+// Event is a single webhook event; use a type switch to get the typed event
+//
+// Every event type embeds EventBase, so the fields common to all events are available through Base
+type Event interface {
+	Base() EventBase
+}
+
+// EventBase holds the fields HubSpot sends with every webhook event
+type EventBase struct {
+	EventID          int64  `json:"eventId"`
+	SubscriptionID   int64  `json:"subscriptionId"`
+	PortalID         int64  `json:"portalId"`
+	AppID            int64  `json:"appId"`
+	OccurredAt       int64  `json:"occurredAt"` // Milliseconds since the Unix epoch
+	SubscriptionType string `json:"subscriptionType"`
+	AttemptNumber    int    `json:"attemptNumber"`
+	ChangeSource     string `json:"changeSource,omitempty"`
+	SourceID         string `json:"sourceId,omitempty"`
+	ObjectTypeID     string `json:"objectTypeId,omitempty"` // Only sent for generic object.* subscriptions
+}
+
+// Base returns the common fields of the event
+func (b EventBase) Base() EventBase {
+	return b
+}
+
+// OccurredTime returns OccurredAt as a time.Time
+func (b EventBase) OccurredTime() time.Time {
+	return time.UnixMilli(b.OccurredAt)
+}
+
+// ObjectType returns the object type part of SubscriptionType, e.g. contact for contact.creation
+//
+// Generic subscriptions return object; use ObjectTypeID to tell their object types apart
+func (b EventBase) ObjectType() string {
+	objectType, _, _ := strings.Cut(b.SubscriptionType, ".")
+	return objectType
+}
+
+// Kind returns the event kind part of SubscriptionType, e.g. creation for contact.creation
+func (b EventBase) Kind() string {
+	_, kind, _ := strings.Cut(b.SubscriptionType, ".")
+	return kind
+}
+
+// ObjectCreated is sent when a record is created
+type ObjectCreated struct {
+	EventBase
+	ObjectID int64 `json:"objectId"`
+}
+
+// ObjectDeleted is sent when a record is archived
+type ObjectDeleted struct {
+	EventBase
+	ObjectID int64 `json:"objectId"`
+}
+
+// ObjectRestored is sent when an archived record is restored
+type ObjectRestored struct {
+	EventBase
+	ObjectID int64 `json:"objectId"`
+}
+
+// ObjectPrivacyDeleted is sent when a record is permanently deleted for privacy compliance
+type ObjectPrivacyDeleted struct {
+	EventBase
+	ObjectID int64 `json:"objectId"`
+}
+
+// ObjectPropertyChanged is sent when a subscribed property of a record changes
+type ObjectPropertyChanged struct {
+	EventBase
+	ObjectID      int64  `json:"objectId"`
+	PropertyName  string `json:"propertyName"`
+	PropertyValue string `json:"propertyValue"`
+}
+
+// AssociationChanged is sent when an association between two records is added or removed
+type AssociationChanged struct {
+	EventBase
+	AssociationType      string `json:"associationType"` // e.g. CONTACT_TO_COMPANY
+	FromObjectID         int64  `json:"fromObjectId"`
+	ToObjectID           int64  `json:"toObjectId"`
+	AssociationRemoved   bool   `json:"associationRemoved"`
+	IsPrimaryAssociation bool   `json:"isPrimaryAssociation"`
+}
+
+// ObjectMerged is sent when records are merged
+type ObjectMerged struct {
+	EventBase
+	PrimaryObjectID         int64   `json:"primaryObjectId"`
+	MergedObjectIDs         []int64 `json:"mergedObjectIds"`
+	NewObjectID             int64   `json:"newObjectId"`
+	NumberOfPropertiesMoved int     `json:"numberOfPropertiesMoved"`
+}
+
+// UnknownEvent is an event whose kind this package doesn't model; Raw holds the event as sent
+type UnknownEvent struct {
+	EventBase
+	Raw json.RawMessage `json:"-"`
+}
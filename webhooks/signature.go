@@ -0,0 +1,92 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers HubSpot sets on v3-signed requests
+const (
+	SignatureHeader = "X-HubSpot-Signature-v3"
+	TimestampHeader = "X-HubSpot-Request-Timestamp"
+)
+
+// MaxTimestampAge is how far a request's timestamp may be from the current time, in either direction, before it is
+// rejected, to stop replayed requests
+const MaxTimestampAge = 5 * time.Minute
+
+// now returns the current time; tests replace it
+var now = time.Now
+
+// uriDecoder decodes the URL-encoded characters HubSpot decodes before signing a request URI
+var uriDecoder = strings.NewReplacer(
+	"%3A", ":", "%2F", "/", "%3F", "?", "%40", "@", "%21", "!", "%24", "$",
+	"%27", "'", "%28", "(", "%29", ")", "%2A", "*", "%2C", ",", "%3B", ";",
+)
+
+// SignedRequest holds the parts of a webhook request, besides its body, that a v3 signature covers
+type SignedRequest struct {
+	Method    string // HTTP method, e.g. POST
+	URI       string // Full URL HubSpot called, including scheme, host and query string
+	Timestamp string // Value of the X-HubSpot-Request-Timestamp header, in milliseconds since the Unix epoch
+}
+
+// VerifySignature checks a v3 signature, the value of the X-HubSpot-Signature-v3 header, against the app secret
+//
+// The signature is the base64-encoded HMAC SHA-256, keyed with the app's client secret, of the method, URI, body and
+// timestamp concatenated. ErrExpiredTimestamp is returned if the timestamp is more than MaxTimestampAge in the past or
+// future, ErrInvalidSignature if the signature doesn't match and ErrMissingSignature if it or the timestamp is empty
+func VerifySignature(secret, signatureHeader string, body []byte, req SignedRequest) error {
+	if signatureHeader == "" || req.Timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	millis, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q is not a timestamp", ErrExpiredTimestamp, req.Timestamp)
+	}
+	age := now().Sub(time.UnixMilli(millis))
+	if age > MaxTimestampAge {
+		return fmt.Errorf("%w: request is %s old", ErrExpiredTimestamp, age.Round(time.Second))
+	}
+	if -age > MaxTimestampAge {
+		return fmt.Errorf("%w: request is %s in the future", ErrExpiredTimestamp, (-age).Round(time.Second))
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(req.Method + uriDecoder.Replace(req.URI)))
+	mac.Write(body)
+	mac.Write([]byte(req.Timestamp))
+
+	signature, err := base64.StdEncoding.DecodeString(signatureHeader)
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyRequest checks the v3 signature of a webhook request, whose body has already been read into body
+//
+// The URI is rebuilt from r's Host and URL, with the scheme taken from the X-Forwarded-Proto header when set
+// behind a proxy and from r.TLS otherwise. If the URL HubSpot calls differs from what the server sees, build the
+// SignedRequest yourself and call VerifySignature
+func VerifyRequest(secret string, r *http.Request, body []byte) error {
+	scheme := "https"
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	} else if r.TLS == nil {
+		scheme = "http"
+	}
+
+	return VerifySignature(secret, r.Header.Get(SignatureHeader), body, SignedRequest{
+		Method:    r.Method,
+		URI:       scheme + "://" + r.Host + r.URL.RequestURI(),
+		Timestamp: r.Header.Get(TimestampHeader),
+	})
+}
@@ -0,0 +1,94 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sign computes a v3 signature the way HubSpot does
+func sign(secret, method, uri string, body []byte, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + uri + string(body) + timestamp))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// fixNow sets the time used for timestamp checks for the rest of the test
+func fixNow(t *testing.T, at time.Time) {
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+// TestVerifySignature tests v3 signature verification
+func TestVerifySignature(t *testing.T) {
+	sentAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	timestamp := strconv.FormatInt(sentAt.UnixMilli(), 10)
+	body := []byte(`[{"eventId": 1}]`)
+	uri := "https://example.com/webhooks?source=hubspot"
+	signature := sign("secret", "POST", uri, body, timestamp)
+	req := SignedRequest{Method: "POST", URI: uri, Timestamp: timestamp}
+
+	t.Run("Valid signature", func(t *testing.T) {
+		fixNow(t, sentAt.Add(time.Minute))
+		assert.NoError(t, VerifySignature("secret", signature, body, req))
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		fixNow(t, sentAt.Add(time.Minute))
+		assert.ErrorIs(t, VerifySignature("other", signature, body, req), ErrInvalidSignature)
+	})
+
+	t.Run("Modified body", func(t *testing.T) {
+		fixNow(t, sentAt.Add(time.Minute))
+		assert.ErrorIs(t, VerifySignature("secret", signature, []byte(`[]`), req), ErrInvalidSignature)
+	})
+
+	t.Run("Expired timestamp", func(t *testing.T) {
+		fixNow(t, sentAt.Add(MaxTimestampAge+time.Second))
+		assert.ErrorIs(t, VerifySignature("secret", signature, body, req), ErrExpiredTimestamp)
+	})
+
+	t.Run("Future timestamp", func(t *testing.T) {
+		fixNow(t, sentAt.Add(-MaxTimestampAge-time.Second))
+		assert.ErrorIs(t, VerifySignature("secret", signature, body, req), ErrExpiredTimestamp)
+	})
+
+	t.Run("Missing signature", func(t *testing.T) {
+		assert.ErrorIs(t, VerifySignature("secret", "", body, req), ErrMissingSignature)
+	})
+
+	t.Run("Encoded URI", func(t *testing.T) {
+		fixNow(t, sentAt)
+		encoded := SignedRequest{Method: "POST", URI: "https://example.com/webhooks?email=a%40example.com", Timestamp: timestamp}
+		decoded := sign("secret", "POST", "https://example.com/webhooks?email=a@example.com", body, timestamp)
+		assert.NoError(t, VerifySignature("secret", decoded, body, encoded))
+	})
+}
+
+// TestVerifyRequest tests verifying an incoming HTTP request
+func TestVerifyRequest(t *testing.T) {
+	sentAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fixNow(t, sentAt)
+	timestamp := strconv.FormatInt(sentAt.UnixMilli(), 10)
+	body := []byte(`[{"eventId": 1}]`)
+
+	r := httptest.NewRequest("POST", "https://example.com/webhooks", strings.NewReader(string(body)))
+	r.Header.Set(TimestampHeader, timestamp)
+	r.Header.Set(SignatureHeader, sign("secret", "POST", "https://example.com/webhooks", body, timestamp))
+	require.NoError(t, VerifyRequest("secret", r, body))
+
+	r = httptest.NewRequest("POST", "http://example.com/webhooks", strings.NewReader(string(body)))
+	r.Header.Set("X-Forwarded-Proto", "https")
+	r.Header.Set(TimestampHeader, timestamp)
+	r.Header.Set(SignatureHeader, sign("secret", "POST", "https://example.com/webhooks", body, timestamp))
+	require.NoError(t, VerifyRequest("secret", r, body))
+}
@@ -0,0 +1,67 @@
+// Package webhooks parses and verifies the webhook events HubSpot sends to an app's target URL
+//
+// Verify each request with VerifyRequest or VerifySignature before trusting it, then decode its body with ParseWebhook
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ParseWebhook decodes a webhook request body into typed events
+//
+// HubSpot batches events into a JSON array; a single event object is also accepted. Events are returned in the order
+// they were sent, as *ObjectCreated, *ObjectPropertyChanged, *AssociationChanged and so on, selected by the kind of
+// their subscriptionType. Events of other kinds are returned as *UnknownEvent rather than failing the whole batch
+func ParseWebhook(body []byte) ([]Event, error) {
+	var raws []json.RawMessage
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		raws = []json.RawMessage{trimmed}
+	} else if err := json.Unmarshal(body, &raws); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook body: %w", err)
+	}
+
+	events := make([]Event, 0, len(raws))
+	for i, raw := range raws {
+		event, err := parseEvent(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook event %d: %w", i, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// parseEvent decodes one event into the type matching its kind
+func parseEvent(raw json.RawMessage) (Event, error) {
+	var base EventBase
+	if err := json.Unmarshal(raw, &base); err != nil {
+		return nil, err
+	}
+
+	var event Event
+	switch base.Kind() {
+	case KindCreation:
+		event = &ObjectCreated{}
+	case KindDeletion:
+		event = &ObjectDeleted{}
+	case KindRestore:
+		event = &ObjectRestored{}
+	case KindPrivacyDeletion:
+		event = &ObjectPrivacyDeleted{}
+	case KindPropertyChange:
+		event = &ObjectPropertyChanged{}
+	case KindAssociationChange:
+		event = &AssociationChanged{}
+	case KindMerge:
+		event = &ObjectMerged{}
+	default:
+		return &UnknownEvent{EventBase: base, Raw: raw}, nil
+	}
+
+	if err := json.Unmarshal(raw, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,90 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseWebhook_Success tests decoding a batch of events into their typed structs
+func TestParseWebhook_Success(t *testing.T) {
+	body := `[
+		{"eventId": 1, "subscriptionId": 10, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "contact.creation", "attemptNumber": 0, "objectId": 123, "changeSource": "CRM"},
+		{"eventId": 2, "subscriptionId": 11, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "company.propertyChange", "attemptNumber": 1, "objectId": 456,
+		 "propertyName": "name", "propertyValue": "Acme", "changeSource": "API", "sourceId": "userId:7"},
+		{"eventId": 3, "subscriptionId": 12, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "contact.associationChange", "associationType": "CONTACT_TO_COMPANY",
+		 "fromObjectId": 123, "toObjectId": 456, "associationRemoved": true, "isPrimaryAssociation": false},
+		{"eventId": 4, "subscriptionId": 13, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "deal.merge", "primaryObjectId": 1, "mergedObjectIds": [2, 3], "newObjectId": 4,
+		 "numberOfPropertiesMoved": 6},
+		{"eventId": 5, "subscriptionId": 14, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "object.deletion", "objectTypeId": "2-123456", "objectId": 789},
+		{"eventId": 6, "subscriptionId": 15, "portalId": 99, "appId": 5, "occurredAt": 1704067200000,
+		 "subscriptionType": "conversation.newMessage", "objectId": 1}
+	]`
+
+	events, err := ParseWebhook([]byte(body))
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+
+	created, ok := events[0].(*ObjectCreated)
+	require.True(t, ok)
+	assert.Equal(t, int64(123), created.ObjectID)
+	assert.Equal(t, "contact", created.ObjectType())
+	assert.Equal(t, KindCreation, created.Kind())
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), created.OccurredTime().UTC())
+
+	changed, ok := events[1].(*ObjectPropertyChanged)
+	require.True(t, ok)
+	assert.Equal(t, "name", changed.PropertyName)
+	assert.Equal(t, "Acme", changed.PropertyValue)
+	assert.Equal(t, "userId:7", changed.SourceID)
+	assert.Equal(t, 1, changed.AttemptNumber)
+
+	association, ok := events[2].(*AssociationChanged)
+	require.True(t, ok)
+	assert.Equal(t, "CONTACT_TO_COMPANY", association.AssociationType)
+	assert.Equal(t, int64(456), association.ToObjectID)
+	assert.True(t, association.AssociationRemoved)
+
+	merged, ok := events[3].(*ObjectMerged)
+	require.True(t, ok)
+	assert.Equal(t, []int64{2, 3}, merged.MergedObjectIDs)
+	assert.Equal(t, int64(4), merged.NewObjectID)
+
+	deleted, ok := events[4].(*ObjectDeleted)
+	require.True(t, ok)
+	assert.Equal(t, "object", deleted.ObjectType())
+	assert.Equal(t, "2-123456", deleted.ObjectTypeID)
+
+	unknown, ok := events[5].(*UnknownEvent)
+	require.True(t, ok)
+	assert.Equal(t, "conversation.newMessage", unknown.Base().SubscriptionType)
+	assert.Contains(t, string(unknown.Raw), "newMessage")
+}
+
+// TestParseWebhook_SingleEvent tests decoding a body holding one event object
+func TestParseWebhook_SingleEvent(t *testing.T) {
+	events, err := ParseWebhook([]byte(` {"eventId": 1, "subscriptionType": "ticket.restore", "objectId": 5}`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	restored, ok := events[0].(*ObjectRestored)
+	require.True(t, ok)
+	assert.Equal(t, int64(5), restored.ObjectID)
+}
+
+// TestParseWebhook_InvalidJSON tests that malformed bodies and events return an error
+func TestParseWebhook_InvalidJSON(t *testing.T) {
+	_, err := ParseWebhook([]byte("invalid json"))
+	require.Error(t, err)
+
+	_, err = ParseWebhook([]byte(`[{"subscriptionType": "contact.creation", "objectId": "not a number"}]`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "event 0")
+}